@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// AGENDA SEMUA PROFIL
+// ==========================================
+
+// agendaLewat adalah jumlah hari ke belakang yang masih ditampilkan di agenda.
+const agendaLewat = 7
+
+// itemAgenda adalah satu acara milik satu profil.
+type itemAgenda struct {
+	Profil profil
+	Jadwal selamatan.Jadwal
+
+	// Bentrok berisi acara lain (dari profil mana pun) pada hari yang sama.
+	Bentrok []string
+}
+
+func (it itemAgenda) judul() string {
+	return it.Jadwal.Acara.Nama + " " + it.Profil.Nama
+}
+
+// susunAgenda menggabungkan jadwal semua profil mulai agendaLewat hari
+// sebelum now, urut menurut tanggal, dan menandai acara yang jatuh pada hari
+// yang sama.
+func susunAgenda(daftar []profil, s appSettings, now time.Time) []itemAgenda {
+	batas := now.AddDate(0, 0, -agendaLewat)
+	var hasil []itemAgenda
+	for _, pr := range daftar {
+		for _, j := range pr.jadwal(s) {
+			if j.Tanggal.Before(batas) {
+				continue
+			}
+			hasil = append(hasil, itemAgenda{Profil: pr, Jadwal: j})
+		}
+	}
+	sort.SliceStable(hasil, func(i, j int) bool {
+		if !hasil[i].Jadwal.Tanggal.Equal(hasil[j].Jadwal.Tanggal) {
+			return hasil[i].Jadwal.Tanggal.Before(hasil[j].Jadwal.Tanggal)
+		}
+		return hasil[i].Profil.Nama < hasil[j].Profil.Nama
+	})
+
+	for i := range hasil {
+		for j := range hasil {
+			if i != j && hasil[i].Jadwal.Tanggal.Equal(hasil[j].Jadwal.Tanggal) {
+				hasil[i].Bentrok = append(hasil[i].Bentrok, hasil[j].judul())
+			}
+		}
+	}
+	return hasil
+}
+
+// awalPekan mengembalikan hari Senin pada pekan tanggal t.
+func awalPekan(t time.Time) time.Time {
+	geser := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -geser)
+}
+
+func formatPekan(senin time.Time) string {
+	minggu := senin.AddDate(0, 0, 6)
+	if senin.Month() == minggu.Month() {
+		return fmt.Sprintf("Pekan %d – %d %s", senin.Day(), minggu.Day(), BulanIndo[minggu.Month()])
+	}
+	return fmt.Sprintf("Pekan %d %s – %d %s", senin.Day(), BulanIndo[senin.Month()], minggu.Day(), BulanIndo[minggu.Month()])
+}
+
+// createAgendaView menampilkan item agenda yang dikelompokkan per bulan lalu
+// per pekan. Mengetuk item memanggil onOpen dengan profilnya.
+func createAgendaView(items []itemAgenda, now time.Time, onOpen func(profil)) fyne.CanvasObject {
+	box := container.NewVBox()
+	var bulan, pekan time.Time
+	for _, it := range items {
+		t := it.Jadwal.Tanggal
+		if b := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()); !b.Equal(bulan) {
+			bulan = b
+			pekan = time.Time{}
+			lblBulan := canvas.NewText(fmt.Sprintf("%s %d", BulanIndo[t.Month()], t.Year()), ColorTextWhite)
+			lblBulan.TextSize = 16
+			lblBulan.TextStyle = fyne.TextStyle{Bold: true}
+			box.Add(container.NewPadded(lblBulan))
+		}
+		if p := awalPekan(t); !p.Equal(pekan) {
+			pekan = p
+			lblPekan := canvas.NewText(formatPekan(p), ColorTextGrey)
+			lblPekan.TextSize = 12
+			lblPekan.TextStyle = fyne.TextStyle{Italic: true}
+			box.Add(lblPekan)
+		}
+
+		lblJudul := canvas.NewText(it.judul(), ColorTextWhite)
+		lblJudul.TextSize = 14
+		lblJudul.TextStyle = fyne.TextStyle{Bold: true}
+		lblTanggal := canvas.NewText(formatIndoDate(t)+" · "+formatWeton(t, it.Profil.Kurup), ColorTextGrey)
+		lblTanggal.TextSize = 11
+		left := container.NewVBox(lblJudul, lblTanggal)
+		if len(it.Bentrok) > 0 {
+			lblBentrok := canvas.NewText("⚠ Bersamaan dengan "+strings.Join(it.Bentrok, ", "), ColorTextOrange)
+			lblBentrok.TextSize = 11
+			left.Add(lblBentrok)
+		}
+
+		diff := int(t.Sub(now).Hours() / 24)
+		row := container.NewBorder(nil, nil, nil, container.NewCenter(createBadge(statusOf(diff), diff)), left)
+		bg := canvas.NewRectangle(ColorCardBg)
+		bg.CornerRadius = 10
+		pr := it.Profil
+		box.Add(newClickableCard(container.NewStack(bg, container.NewPadded(row)), func() { onOpen(pr) }))
+	}
+	return box
+}
@@ -0,0 +1,97 @@
+// Package bacaan menyimpan teks bacaan yang dipakai saat selamatan, yaitu
+// urutan tahlil, Surah Yasin dan doa arwah, lengkap dengan tulisan Arab,
+// latin dan artinya. Teks bawaan disertakan di dalam aplikasi sehingga bisa
+// dibaca tanpa internet; bacaan lain bisa diimpor dari berkas JSON dengan
+// bentuk yang sama.
+package bacaan
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+)
+
+// ==========================================
+// DEFINISI BACAAN
+// ==========================================
+
+// Bagian adalah satu bacaan di dalam urutan, misalnya satu surah atau satu
+// doa.
+type Bagian struct {
+	Judul string `json:"judul"`
+	Arab  string `json:"arab,omitempty"`
+	Latin string `json:"latin,omitempty"`
+	Arti  string `json:"arti,omitempty"`
+
+	// Ulang adalah jumlah bacaan diulang. 0 dan 1 sama-sama berarti sekali.
+	Ulang   int    `json:"ulang,omitempty"`
+	Catatan string `json:"catatan,omitempty"`
+}
+
+// Bacaan adalah satu rangkaian bacaan, misalnya urutan tahlil.
+type Bacaan struct {
+	ID         string   `json:"id"`
+	Judul      string   `json:"judul"`
+	Keterangan string   `json:"keterangan,omitempty"`
+	Bagian     []Bagian `json:"bagian"`
+}
+
+// Validate memeriksa bacaan sebelum dipakai atau disimpan.
+func (b Bacaan) Validate() error {
+	if b.ID == "" || b.Judul == "" {
+		return fmt.Errorf("bacaan harus punya id dan judul")
+	}
+	if len(b.Bagian) == 0 {
+		return fmt.Errorf("bacaan %q tidak punya bagian", b.ID)
+	}
+	for i, bg := range b.Bagian {
+		if bg.Judul == "" {
+			return fmt.Errorf("bacaan %q: bagian ke-%d tanpa judul", b.ID, i+1)
+		}
+		if bg.Arab == "" && bg.Latin == "" {
+			return fmt.Errorf("bacaan %q: bagian %q kosong", b.ID, bg.Judul)
+		}
+		if bg.Ulang < 0 {
+			return fmt.Errorf("bacaan %q: bagian %q punya ulang negatif", b.ID, bg.Judul)
+		}
+	}
+	return nil
+}
+
+// Parse membaca bacaan dari JSON dan memvalidasinya.
+func Parse(data []byte) (Bacaan, error) {
+	var b Bacaan
+	if err := json.Unmarshal(data, &b); err != nil {
+		return Bacaan{}, fmt.Errorf("bacaan tidak bisa dibaca: %w", err)
+	}
+	if err := b.Validate(); err != nil {
+		return Bacaan{}, err
+	}
+	return b, nil
+}
+
+//go:embed teks/*.json
+var teksFS embed.FS
+
+// Bawaan mengembalikan bacaan yang disertakan di dalam aplikasi, urut menurut
+// nama berkas.
+func Bawaan() []Bacaan {
+	entries, err := teksFS.ReadDir("teks")
+	if err != nil {
+		panic(err)
+	}
+	var hasil []Bacaan
+	for _, e := range entries {
+		data, err := teksFS.ReadFile(path.Join("teks", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		b, err := Parse(data)
+		if err != nil {
+			panic(fmt.Sprintf("bacaan bawaan %s rusak: %v", e.Name(), err))
+		}
+		hasil = append(hasil, b)
+	}
+	return hasil
+}
@@ -0,0 +1,66 @@
+package bacaan
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBawaan(t *testing.T) {
+	daftar := Bawaan()
+	var id []string
+	for _, b := range daftar {
+		id = append(id, b.ID)
+	}
+	if got, want := strings.Join(id, ","), "tahlil,yasin,doa-arwah"; got != want {
+		t.Fatalf("bacaan bawaan = %s, want %s", got, want)
+	}
+}
+
+func TestYasin(t *testing.T) {
+	var yasin Bacaan
+	for _, b := range Bawaan() {
+		if b.ID == "yasin" {
+			yasin = b
+		}
+	}
+	// Basmalah lalu 83 ayat.
+	if len(yasin.Bagian) != 84 {
+		t.Fatalf("Yasin punya %d bagian, want 84", len(yasin.Bagian))
+	}
+	angka := strings.NewReplacer("0", "٠", "1", "١", "2", "٢", "3", "٣", "4", "٤", "5", "٥", "6", "٦", "7", "٧", "8", "٨", "9", "٩")
+	for i, bg := range yasin.Bagian[1:] {
+		n := i + 1
+		if want := fmt.Sprintf("Ayat %d", n); bg.Judul != want {
+			t.Errorf("bagian %d berjudul %q, want %q", n, bg.Judul, want)
+		}
+		if want := "﴿" + angka.Replace(fmt.Sprint(n)) + "﴾"; !strings.HasSuffix(bg.Arab, want) {
+			t.Errorf("ayat %d tidak diakhiri nomor %s: %q", n, want, bg.Arab)
+		}
+		if bg.Latin == "" || bg.Arti == "" {
+			t.Errorf("ayat %d tanpa latin atau arti", n)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		nama string
+		json string
+		ok   bool
+	}{
+		{"lengkap", `{"id":"x","judul":"X","bagian":[{"judul":"A","arab":"ب"}]}`, true},
+		{"latin saja", `{"id":"x","judul":"X","bagian":[{"judul":"A","latin":"b"}]}`, true},
+		{"tanpa id", `{"judul":"X","bagian":[{"judul":"A","arab":"ب"}]}`, false},
+		{"tanpa bagian", `{"id":"x","judul":"X"}`, false},
+		{"bagian kosong", `{"id":"x","judul":"X","bagian":[{"judul":"A"}]}`, false},
+		{"ulang negatif", `{"id":"x","judul":"X","bagian":[{"judul":"A","arab":"ب","ulang":-1}]}`, false},
+		{"bukan json", `judul: X`, false},
+	}
+	for _, tt := range tests {
+		_, err := Parse([]byte(tt.json))
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: err = %v, want ok %v", tt.nama, err, tt.ok)
+		}
+	}
+}
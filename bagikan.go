@@ -0,0 +1,211 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+)
+
+// ==========================================
+// BAGIKAN JADWAL SEBAGAI TEKS
+// ==========================================
+
+const prefTemplateBagikan = "template_bagikan" // JSON map[string]templateBagikan
+
+// templateBagikan menyusun pesan dari Kepala diikuti Baris untuk setiap acara.
+// Penanda {nama} dst diganti dengan nilai sebenarnya, lihat PenandaKepala dan
+// PenandaBaris.
+type templateBagikan struct {
+	Kepala string `json:"kepala"`
+	Baris  string `json:"baris"`
+}
+
+var (
+	PenandaKepala = []string{"{judul}", "{geblag}", "{weton_geblag}", "{pakem}", "{kurup}"}
+	PenandaBaris  = []string{"{acara}", "{sub}", "{tanggal}", "{weton}", "{tahun_jawa}", "{rumus}", "{status}", "{tanggal_lain}"}
+)
+
+// Varian pesan. Kuncinya dipakai juga untuk menyimpan template.
+var NamaVarianBagikan = []string{"Pendek", "Lengkap"}
+
+var templateBagikanBawaan = map[string]templateBagikan{
+	"Pendek": {
+		Kepala: "*{judul}*\nGeblag: {geblag}\n",
+		Baris:  "• {acara}: {tanggal} ({weton}) {status}",
+	},
+	"Lengkap": {
+		Kepala: "*{judul}*\nGeblag: {geblag}, {weton_geblag}\nPakem {pakem}, kurup {kurup}\n",
+		Baris:  "\n*{acara}* ({sub})\n{tanggal}\n{weton}\n{tahun_jawa}\nRumus {rumus} · {status}\n{tanggal_lain}",
+	},
+}
+
+func loadTemplateBagikan(p fyne.Preferences) map[string]templateBagikan {
+	hasil := map[string]templateBagikan{}
+	for k, v := range templateBagikanBawaan {
+		hasil[k] = v
+	}
+	var tersimpan map[string]templateBagikan
+	if err := loadJSONPref(p, prefTemplateBagikan, &tersimpan); err != nil {
+		fmt.Println("Template bagikan rusak:", err)
+	}
+	for k, v := range tersimpan {
+		hasil[k] = v
+	}
+	return hasil
+}
+
+// teks menyusun pesan dari jadwal e. now dipakai untuk status tiap acara.
+func (tpl templateBagikan) teks(e jadwalEkspor, now time.Time) string {
+	wg := jawa.WetonOf(e.Geblag)
+	kepala := strings.NewReplacer(
+		"{judul}", e.judul(),
+		"{geblag}", formatIndoDate(e.Geblag),
+		"{weton_geblag}", wg.String(),
+		"{pakem}", e.Pakem,
+		"{kurup}", e.Kurup.String(),
+	).Replace(tpl.Kepala)
+
+	var baris []string
+	for _, j := range e.Jadwal {
+		diff := jawa.DateToJDN(j.Tanggal) - jawa.DateToJDN(now)
+		b := strings.NewReplacer(
+			"{acara}", j.Acara.Nama,
+			"{sub}", j.Acara.Sub,
+			"{tanggal}", formatIndoDate(j.Tanggal),
+			"{weton}", formatWeton(j.Tanggal, e.Kurup),
+			"{tahun_jawa}", formatTahunJawa(j.Tanggal, e.Kurup),
+			"{rumus}", j.Rumus.String(),
+			"{status}", formatStatus(diff),
+			"{tanggal_lain}", formatTanggalLain(j),
+		).Replace(tpl.Baris)
+		baris = append(baris, strings.TrimRight(b, "\n"))
+	}
+	return strings.TrimSpace(kepala + strings.Join(baris, "\n"))
+}
+
+// showBagikanPopup menampilkan pesan yang bisa disunting, disalin ke clipboard
+// atau dikirim lewat tautan wa.me. Fyne belum menyediakan lembar berbagi
+// (share sheet) sistem, jadi aplikasi lain cukup lewat salin-tempel.
+func showBagikanPopup(a fyne.App, parentCanvas fyne.Canvas, e jadwalEkspor) {
+	prefs := a.Preferences()
+	templates := loadTemplateBagikan(prefs)
+
+	entryPesan := widget.NewMultiLineEntry()
+	entryPesan.Wrapping = fyne.TextWrapWord
+	entryPesan.SetMinRowsVisible(12)
+
+	selVarian := widget.NewSelect(NamaVarianBagikan, func(v string) {
+		entryPesan.SetText(templates[v].teks(e, time.Now()))
+	})
+	selVarian.SetSelectedIndex(0)
+
+	lblInfo := canvas.NewText("", ColorTextGrey)
+	lblInfo.TextSize = 11
+	noteKirim := widget.NewLabel("Tombol WhatsApp membuka wa.me di aplikasi atau peramban. Untuk aplikasi lain, salin pesan lalu tempel di sana.")
+	noteKirim.Wrapping = fyne.TextWrapWord
+	noteKirim.TextStyle = fyne.TextStyle{Italic: true}
+
+	btnTemplate := widget.NewButtonWithIcon("Template", theme.DocumentCreateIcon(), func() {
+		showTemplateBagikanPopup(parentCanvas, prefs, selVarian.Selected, func() {
+			templates = loadTemplateBagikan(prefs)
+			entryPesan.SetText(templates[selVarian.Selected].teks(e, time.Now()))
+		})
+	})
+	btnTemplate.Importance = widget.LowImportance
+
+	body := container.NewVBox(
+		container.NewBorder(nil, nil, nil, btnTemplate, selVarian),
+		entryPesan,
+		noteKirim,
+		lblInfo,
+	)
+
+	btnSalin := widget.NewButtonWithIcon("Salin", theme.ContentCopyIcon(), func() {
+		a.Clipboard().SetContent(entryPesan.Text)
+		lblInfo.Text = "Pesan disalin ke clipboard."
+		lblInfo.Refresh()
+	})
+	btnWA := widget.NewButtonWithIcon("Kirim ke WhatsApp", theme.MailSendIcon(), func() {
+		u, err := url.Parse("https://wa.me/?text=" + url.QueryEscape(entryPesan.Text))
+		if err == nil {
+			err = a.OpenURL(u)
+		}
+		if err != nil {
+			a.Clipboard().SetContent(entryPesan.Text)
+			lblInfo.Text = "Tidak bisa membuka WhatsApp, pesan disalin ke clipboard."
+			lblInfo.Refresh()
+		}
+	})
+	btnWA.Importance = widget.HighImportance
+
+	showModalCard(parentCanvas, "Bagikan Jadwal", body, btnSalin, btnWA)
+}
+
+// showTemplateBagikanPopup menampilkan form untuk mengubah template varian.
+func showTemplateBagikanPopup(parentCanvas fyne.Canvas, prefs fyne.Preferences, varian string, onSaved func()) {
+	tpl := loadTemplateBagikan(prefs)[varian]
+
+	entryKepala := widget.NewMultiLineEntry()
+	entryKepala.Wrapping = fyne.TextWrapWord
+	entryKepala.SetText(tpl.Kepala)
+	entryBaris := widget.NewMultiLineEntry()
+	entryBaris.Wrapping = fyne.TextWrapWord
+	entryBaris.SetText(tpl.Baris)
+
+	label := func(s string) *canvas.Text {
+		t := canvas.NewText(s, ColorTextGrey)
+		t.TextSize = 12
+		return t
+	}
+	notePenanda := widget.NewLabel(fmt.Sprintf("Kepala: %s\nTiap acara: %s",
+		strings.Join(PenandaKepala, " "), strings.Join(PenandaBaris, " ")))
+	notePenanda.Wrapping = fyne.TextWrapWord
+	notePenanda.TextStyle = fyne.TextStyle{Italic: true}
+
+	body := container.NewVBox(
+		label("Kepala pesan:"),
+		entryKepala,
+		label("Baris tiap acara:"),
+		entryBaris,
+		notePenanda,
+	)
+
+	simpan := func(t templateBagikan) {
+		var tersimpan map[string]templateBagikan
+		if err := loadJSONPref(prefs, prefTemplateBagikan, &tersimpan); err != nil || tersimpan == nil {
+			tersimpan = map[string]templateBagikan{}
+		}
+		if t == templateBagikanBawaan[varian] {
+			delete(tersimpan, varian)
+		} else {
+			tersimpan[varian] = t
+		}
+		saveJSONPref(prefs, prefTemplateBagikan, tersimpan)
+		if onSaved != nil {
+			onSaved()
+		}
+	}
+
+	var popup *widget.PopUp
+	btnReset := widget.NewButton("Bawaan", func() {
+		simpan(templateBagikanBawaan[varian])
+		popup.Hide()
+	})
+	btnReset.Importance = widget.LowImportance
+	btnSimpan := widget.NewButton("Simpan", func() {
+		simpan(templateBagikan{Kepala: entryKepala.Text, Baris: entryBaris.Text})
+		popup.Hide()
+	})
+	btnSimpan.Importance = widget.HighImportance
+
+	popup = showModalCard(parentCanvas, "Template "+varian, body, btnReset, btnSimpan)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// Setiap varian bawaan harus memuat nama acara, tanggal, weton dan status
+// setiap acara.
+func TestTemplateBagikanBawaan(t *testing.T) {
+	geblag := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	o := selamatan.Opsi{Kurup: jawa.Asapon}
+	e := jadwalEkspor{
+		Nama:   "Almarhum Sastro",
+		Geblag: geblag,
+		Kurup:  o.Kurup,
+		Pakem:  "Standar",
+		Jadwal: selamatan.PakemBawaan()[0].Hitung(geblag, o),
+	}
+	now := time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC) // hari Mitung
+
+	for _, varian := range NamaVarianBagikan {
+		tpl, ok := templateBagikanBawaan[varian]
+		if !ok {
+			t.Fatalf("varian %s tanpa template bawaan", varian)
+		}
+		teks := tpl.teks(e, now)
+		if !strings.Contains(teks, e.judul()) {
+			t.Errorf("%s: judul %q tidak ada", varian, e.judul())
+		}
+		for _, j := range e.Jadwal {
+			diff := jawa.DateToJDN(j.Tanggal) - jawa.DateToJDN(now)
+			for _, bagian := range []string{j.Acara.Nama, formatIndoDate(j.Tanggal), formatWeton(j.Tanggal, e.Kurup), formatStatus(diff)} {
+				if !strings.Contains(teks, bagian) {
+					t.Errorf("%s: %s tanpa %q", varian, j.Acara.Nama, bagian)
+				}
+			}
+		}
+		if strings.ContainsAny(teks, "{}") {
+			t.Errorf("%s: masih ada penanda yang tidak diganti:\n%s", varian, teks)
+		}
+	}
+}
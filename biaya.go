@@ -0,0 +1,306 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// CATATAN BIAYA SELAMATAN
+// ==========================================
+
+const prefBiaya = "biaya" // JSON map[profil.ID][]pengeluaran
+
+// pengeluaran adalah satu catatan biaya untuk satu acara.
+type pengeluaran struct {
+	Acara    string `json:"acara"`
+	Item     string `json:"item"`
+	Jumlah   int64  `json:"jumlah"` // rupiah
+	Pembayar string `json:"pembayar,omitempty"`
+}
+
+// formatRupiah menulis n dengan pemisah ribuan titik, misalnya Rp 1.250.000.
+func formatRupiah(n int64) string {
+	tanda := ""
+	if n < 0 {
+		tanda, n = "-", -n
+	}
+	s := strconv.FormatInt(n, 10)
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(r)
+	}
+	return "Rp " + tanda + b.String()
+}
+
+// parseRupiah membaca jumlah uang dengan mengabaikan selain angka, sehingga
+// "Rp 1.250.000" dan "1250000" sama. Koma yang diikuti satu atau dua angka di
+// akhir dibaca sebagai sen dan dibulatkan ke rupiah terdekat, sehingga
+// "1.250.000,50" menjadi 1250001.
+func parseRupiah(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	sen := 0
+	if i := strings.LastIndexByte(s, ','); i >= 0 {
+		if ekor := s[i+1:]; len(ekor) >= 1 && len(ekor) <= 2 && strings.Trim(ekor, "0123456789") == "" {
+			sen, _ = strconv.Atoi(ekor)
+			if len(ekor) == 1 {
+				sen *= 10
+			}
+			s = s[:i]
+		}
+	}
+	angka := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+	if angka == "" {
+		return 0, fmt.Errorf("jumlah kosong")
+	}
+	n, err := strconv.ParseInt(angka, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if sen >= 50 {
+		n++
+	}
+	return n, nil
+}
+
+func loadBiayaSemua(p fyne.Preferences) map[string][]pengeluaran {
+	var semua map[string][]pengeluaran
+	if err := loadJSONPref(p, prefBiaya, &semua); err != nil {
+		fmt.Println("Catatan biaya rusak:", err)
+	}
+	if semua == nil {
+		semua = map[string][]pengeluaran{}
+	}
+	return semua
+}
+
+func loadBiaya(p fyne.Preferences, pr profil) []pengeluaran {
+	return loadBiayaSemua(p)[pr.ID]
+}
+
+func saveBiaya(p fyne.Preferences, pr profil, daftar []pengeluaran) {
+	semua := loadBiayaSemua(p)
+	if len(daftar) == 0 {
+		delete(semua, pr.ID)
+	} else {
+		semua[pr.ID] = daftar
+	}
+	saveJSONPref(p, prefBiaya, semua)
+}
+
+// totalBiaya menjumlahkan pengeluaran. Bila acara tidak kosong, hanya
+// pengeluaran acara itu yang dihitung.
+func totalBiaya(daftar []pengeluaran, acara string) int64 {
+	var total int64
+	for _, e := range daftar {
+		if acara == "" || e.Acara == acara {
+			total += e.Jumlah
+		}
+	}
+	return total
+}
+
+// tulisCSVBiaya menulis seluruh pengeluaran profil, urut menurut jadwal.
+// Kolom tanggal diambil dari jadwal acaranya.
+func tulisCSVBiaya(w *csv.Writer, pr profil, jadwal []selamatan.Jadwal, daftar []pengeluaran) error {
+	urutan := map[string]int{}
+	tanggal := map[string]string{}
+	for i, j := range jadwal {
+		urutan[j.Acara.Nama] = i
+		tanggal[j.Acara.Nama] = j.Tanggal.Format("2006-01-02")
+	}
+	daftar = append([]pengeluaran{}, daftar...)
+	sort.SliceStable(daftar, func(i, j int) bool {
+		return urutan[daftar[i].Acara] < urutan[daftar[j].Acara]
+	})
+
+	baris := [][]string{{"Profil", "Acara", "Tanggal", "Item", "Jumlah", "Pembayar"}}
+	for _, e := range daftar {
+		baris = append(baris, []string{pr.String(), e.Acara, tanggal[e.Acara], e.Item, strconv.FormatInt(e.Jumlah, 10), e.Pembayar})
+	}
+	baris = append(baris, []string{pr.String(), "Total", "", "", strconv.FormatInt(totalBiaya(daftar, ""), 10), ""})
+	return w.WriteAll(baris)
+}
+
+// createBiayaButton membuat indikator total biaya acara untuk kartu.
+// Mengetuknya membuka catatan biaya; onChanged dipanggil setelah ada
+// perubahan.
+func createBiayaButton(parentCanvas fyne.Canvas, prefs fyne.Preferences, pr profil, acara string, onChanged func()) fyne.CanvasObject {
+	btn := widget.NewButton(formatRupiah(totalBiaya(loadBiaya(prefs, pr), acara)), nil)
+	btn.Importance = widget.LowImportance
+	btn.OnTapped = func() {
+		showBiayaPopup(parentCanvas, prefs, pr, acara, func() {
+			btn.SetText(formatRupiah(totalBiaya(loadBiaya(prefs, pr), acara)))
+			if onChanged != nil {
+				onChanged()
+			}
+		})
+	}
+	return btn
+}
+
+// showBiayaPopup menampilkan dan menambah catatan biaya satu acara.
+func showBiayaPopup(parentCanvas fyne.Canvas, prefs fyne.Preferences, pr profil, acara string, onChanged func()) {
+	list := container.NewVBox()
+	lblTotal := canvas.NewText("", ColorTextWhite)
+	lblTotal.TextStyle = fyne.TextStyle{Bold: true}
+
+	var refreshList func()
+	refreshList = func() {
+		semua := loadBiaya(prefs, pr)
+		list.Objects = nil
+		for i, e := range semua {
+			if e.Acara != acara {
+				continue
+			}
+			idx := i
+			teks := e.Item + " · " + formatRupiah(e.Jumlah)
+			if e.Pembayar != "" {
+				teks += " (" + e.Pembayar + ")"
+			}
+			lbl := widget.NewLabel(teks)
+			lbl.Wrapping = fyne.TextWrapWord
+			btnDel := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				semua := loadBiaya(prefs, pr)
+				saveBiaya(prefs, pr, append(semua[:idx], semua[idx+1:]...))
+				refreshList()
+				onChanged()
+			})
+			btnDel.Importance = widget.LowImportance
+			list.Add(container.NewBorder(nil, nil, nil, btnDel, lbl))
+		}
+		if len(list.Objects) == 0 {
+			lblEmpty := canvas.NewText("Belum ada catatan biaya.", ColorTextGrey)
+			lblEmpty.TextSize = 12
+			list.Add(lblEmpty)
+		}
+		lblTotal.Text = "Total " + acara + ": " + formatRupiah(totalBiaya(semua, acara))
+		lblTotal.Refresh()
+		list.Refresh()
+	}
+	refreshList()
+
+	entryItem := widget.NewEntry()
+	entryItem.SetPlaceHolder("Item, mis. Berkat 50 kotak")
+	entryJumlah := widget.NewEntry()
+	entryJumlah.SetPlaceHolder("Jumlah (Rp)")
+	entryPembayar := widget.NewEntry()
+	entryPembayar.SetPlaceHolder("Dibayar oleh (opsional)")
+	lblError := canvas.NewText("", ColorBadgeRed)
+	lblError.TextSize = 11
+
+	btnTambah := widget.NewButtonWithIcon("Tambah", theme.ContentAddIcon(), func() {
+		item := strings.TrimSpace(entryItem.Text)
+		jumlah, err := parseRupiah(entryJumlah.Text)
+		if item == "" || err != nil {
+			lblError.Text = "Isi item dan jumlah."
+			lblError.Refresh()
+			return
+		}
+		lblError.Text = ""
+		lblError.Refresh()
+		saveBiaya(prefs, pr, append(loadBiaya(prefs, pr), pengeluaran{
+			Acara:    acara,
+			Item:     item,
+			Jumlah:   jumlah,
+			Pembayar: strings.TrimSpace(entryPembayar.Text),
+		}))
+		entryItem.SetText("")
+		entryJumlah.SetText("")
+		refreshList()
+		onChanged()
+	})
+
+	body := container.NewVBox(
+		list,
+		lblTotal,
+		widget.NewSeparator(),
+		entryItem,
+		entryJumlah,
+		entryPembayar,
+		lblError,
+	)
+	showModalCard(parentCanvas, "Biaya "+acara+" · "+pr.Nama, body, btnTambah)
+}
+
+// createBiayaRingkasan membuat kartu total biaya profil, per acara dan per
+// pembayar, beserta tombol ekspor CSV.
+func createBiayaRingkasan(win fyne.Window, prefs fyne.Preferences, pr profil, jadwal []selamatan.Jadwal) fyne.CanvasObject {
+	daftar := loadBiaya(prefs, pr)
+
+	var baris []string
+	for _, j := range jadwal {
+		if t := totalBiaya(daftar, j.Acara.Nama); t > 0 {
+			baris = append(baris, fmt.Sprintf("%s: %s", j.Acara.Nama, formatRupiah(t)))
+		}
+	}
+	perPembayar := map[string]int64{}
+	var pembayar []string
+	for _, e := range daftar {
+		nama := e.Pembayar
+		if nama == "" {
+			nama = "(tanpa nama)"
+		}
+		if _, ok := perPembayar[nama]; !ok {
+			pembayar = append(pembayar, nama)
+		}
+		perPembayar[nama] += e.Jumlah
+	}
+	if len(pembayar) > 0 {
+		baris = append(baris, "")
+		for _, nama := range pembayar {
+			baris = append(baris, fmt.Sprintf("Dibayar %s: %s", nama, formatRupiah(perPembayar[nama])))
+		}
+	}
+	if len(baris) == 0 {
+		baris = append(baris, "Belum ada catatan biaya. Ketuk jumlah Rp pada kartu acara untuk menambah.")
+	}
+
+	lblTitle := canvas.NewText("Biaya "+pr.Nama+": "+formatRupiah(totalBiaya(daftar, "")), ColorTextWhite)
+	lblTitle.TextSize = 12
+	lblTitle.TextStyle = fyne.TextStyle{Bold: true}
+	lblText := widget.NewLabel(strings.Join(baris, "\n"))
+	lblText.Wrapping = fyne.TextWrapWord
+
+	btnCSV := widget.NewButtonWithIcon("CSV", theme.DocumentSaveIcon(), func() {
+		save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil || w == nil {
+				return
+			}
+			defer w.Close()
+			if err := tulisCSVBiaya(csv.NewWriter(w), pr, jadwal, loadBiaya(prefs, pr)); err != nil {
+				dialog.ShowError(err, win)
+			}
+		}, win)
+		save.SetFileName(jadwalEkspor{Nama: pr.String()}.namaBerkas("-biaya.csv"))
+		save.Show()
+	})
+	btnCSV.Importance = widget.LowImportance
+
+	bg := canvas.NewRectangle(ColorCardBg)
+	bg.CornerRadius = 10
+	return container.NewStack(bg, container.NewPadded(container.NewVBox(
+		container.NewBorder(nil, nil, nil, btnCSV, lblTitle),
+		lblText,
+	)))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+func TestParseRupiah(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"1250000", 1250000},
+		{"Rp 1.250.000", 1250000},
+		{"1.250.000,00", 1250000},
+		{"1.250.000,49", 1250000},
+		{"1.250.000,50", 1250001},
+		{"1.250.000,5", 1250001},
+		{"Rp 75.000,-", 75000},
+		{"1,250,000", 1250000},
+		{"0,75", 1},
+		{" 25.000 ", 25000},
+	}
+	for _, tt := range tests {
+		got, err := parseRupiah(tt.in)
+		if err != nil {
+			t.Errorf("parseRupiah(%q) error: %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseRupiah(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "Rp", ",50"} {
+		if _, err := parseRupiah(in); err == nil {
+			t.Errorf("parseRupiah(%q) tidak mengembalikan error", in)
+		}
+	}
+}
+
+func TestTulisCSVBiaya(t *testing.T) {
+	geblag := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	jadwal := selamatan.PakemBawaan()[0].Hitung(geblag, selamatan.Opsi{Kurup: jawa.Asapon})
+	pr := profil{ID: "a1", Sebutan: "Bapak", Nama: "Sastro"}
+	daftar := []pengeluaran{
+		{Acara: "Mitung", Item: "Berkat", Jumlah: 500000},
+		{Acara: "Geblag", Item: "Tenda", Jumlah: 250000, Pembayar: "Budi"},
+	}
+
+	var b bytes.Buffer
+	if err := tulisCSVBiaya(csv.NewWriter(&b), pr, jadwal, daftar); err != nil {
+		t.Fatal(err)
+	}
+	want := "Profil,Acara,Tanggal,Item,Jumlah,Pembayar\n" +
+		"Bapak Sastro,Geblag,2024-03-01,Tenda,250000,Budi\n" +
+		"Bapak Sastro,Mitung,2024-03-07,Berkat,500000,\n" +
+		"Bapak Sastro,Total,,,750000,\n"
+	if got := b.String(); got != want {
+		t.Errorf("CSV =\n%s\nwant\n%s", got, want)
+	}
+
+	// Kegagalan menulis baris harus diteruskan ke pemanggil.
+	w := csv.NewWriter(&bytes.Buffer{})
+	w.Comma = '"'
+	if err := tulisCSVBiaya(w, pr, jadwal, daftar); err == nil {
+		t.Error("tulisCSVBiaya mengabaikan error dari csv.Writer")
+	}
+}
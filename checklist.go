@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ==========================================
+// CHECKLIST PERSIAPAN SELAMATAN
+// ==========================================
+
+const prefChecklist = "checklist" // JSON map[profil.ID]map[nama acara]checklist
+
+// jedaSimpanCatatan adalah waktu tunggu setelah ketikan terakhir sebelum
+// catatan disimpan, agar JSON checklist tidak ditulis ulang tiap huruf.
+const jedaSimpanCatatan = 700 * time.Millisecond
+
+// checklistUmum berlaku untuk setiap selamatan. checklistBawaan menambah
+// persiapan khusus per jenis acara.
+var checklistUmum = []string{
+	"Hubungi modin/ustadz pemimpin tahlil",
+	"Undang jamaah tahlil dan tetangga",
+	"Pesan atau siapkan berkat",
+	"Siapkan konsumsi tamu",
+	"Siapkan tikar, sound system dan tempat",
+}
+
+var checklistBawaan = map[string][]string{
+	"Geblag":     {"Kabari kerabat dan tetangga", "Urus surat keterangan kematian", "Siapkan sedekah malam pertama"},
+	"Nelung":     {"Ubo rampe: sego golong, ingkung"},
+	"Mitung":     {"Ubo rampe: sego golong, ingkung, apem"},
+	"Matang":     {"Ubo rampe: apem, ketan, kolak"},
+	"Nyatus":     {"Ubo rampe: apem, ketan, kolak, ingkung"},
+	"Pendhak I":  {"Ubo rampe pendhak: apem, ketan, kolak", "Nyekar ke makam"},
+	"Pendhak II": {"Ubo rampe pendhak: apem, ketan, kolak", "Nyekar ke makam"},
+	"Nyewu":      {"Ubo rampe nyewu: ingkung, apem, ketan, kolak", "Pasang kijing atau nisan permanen", "Nyekar ke makam", "Sedekah pakaian almarhum/almarhumah"},
+}
+
+type itemChecklist struct {
+	Teks    string `json:"teks"`
+	Selesai bool   `json:"selesai"`
+}
+
+// checklist adalah daftar persiapan satu acara milik satu profil.
+type checklist struct {
+	Item    []itemChecklist `json:"item"`
+	Catatan string          `json:"catatan,omitempty"`
+}
+
+// checklistBaru membuat checklist dari template acara.
+func checklistBaru(acara string) checklist {
+	var c checklist
+	for _, t := range append(append([]string{}, checklistUmum...), checklistBawaan[acara]...) {
+		c.Item = append(c.Item, itemChecklist{Teks: t})
+	}
+	return c
+}
+
+func (c checklist) progres() (selesai, total int) {
+	for _, it := range c.Item {
+		if it.Selesai {
+			selesai++
+		}
+	}
+	return selesai, len(c.Item)
+}
+
+func loadChecklistSemua(p fyne.Preferences) map[string]map[string]checklist {
+	var semua map[string]map[string]checklist
+	if err := loadJSONPref(p, prefChecklist, &semua); err != nil {
+		fmt.Println("Checklist rusak:", err)
+	}
+	if semua == nil {
+		semua = map[string]map[string]checklist{}
+	}
+	return semua
+}
+
+// loadChecklist mengembalikan checklist tersimpan, atau template bawaan bila
+// belum pernah diubah.
+func loadChecklist(p fyne.Preferences, pr profil, acara string) checklist {
+	if c, ok := loadChecklistSemua(p)[pr.ID][acara]; ok {
+		return c
+	}
+	return checklistBaru(acara)
+}
+
+func saveChecklist(p fyne.Preferences, pr profil, acara string, c checklist) {
+	semua := loadChecklistSemua(p)
+	if semua[pr.ID] == nil {
+		semua[pr.ID] = map[string]checklist{}
+	}
+	semua[pr.ID][acara] = c
+	saveJSONPref(p, prefChecklist, semua)
+}
+
+// hapusChecklistProfil membuang semua checklist milik profil yang dihapus.
+func hapusChecklistProfil(p fyne.Preferences, id string) {
+	semua := loadChecklistSemua(p)
+	if _, ok := semua[id]; ok {
+		delete(semua, id)
+		saveJSONPref(p, prefChecklist, semua)
+	}
+}
+
+func formatProgres(c checklist) string {
+	selesai, total := c.progres()
+	return fmt.Sprintf("☑ %d/%d", selesai, total)
+}
+
+// createChecklistButton membuat indikator progres checklist untuk kartu
+// acara. Mengetuknya membuka checklist.
+func createChecklistButton(parentCanvas fyne.Canvas, prefs fyne.Preferences, pr profil, acara string) fyne.CanvasObject {
+	btn := widget.NewButton(formatProgres(loadChecklist(prefs, pr, acara)), nil)
+	btn.Importance = widget.LowImportance
+	btn.OnTapped = func() {
+		showChecklistPopup(parentCanvas, prefs, pr, acara, func(c checklist) {
+			btn.SetText(formatProgres(c))
+		})
+	}
+	return btn
+}
+
+// penunda mengembalikan pemicu yang menjalankan f di thread UI setelah jeda
+// berlalu tanpa pemicuan baru. Pemicuan beruntun hanya menghasilkan satu
+// panggilan f. Pemicu harus dipanggil dari thread UI.
+func penunda(jeda time.Duration, f func()) func() {
+	var timer *time.Timer
+	return func() {
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(jeda, func() { fyne.Do(f) })
+	}
+}
+
+// showChecklistPopup menampilkan checklist acara. Setiap perubahan langsung
+// disimpan dan diteruskan ke onChanged, kecuali catatan yang baru disimpan
+// setelah pengguna berhenti mengetik.
+func showChecklistPopup(parentCanvas fyne.Canvas, prefs fyne.Preferences, pr profil, acara string, onChanged func(checklist)) {
+	c := loadChecklist(prefs, pr, acara)
+	simpan := func() {
+		saveChecklist(prefs, pr, acara, c)
+		if onChanged != nil {
+			onChanged(c)
+		}
+	}
+
+	list := container.NewVBox()
+	var refreshList func()
+	refreshList = func() {
+		list.Objects = nil
+		for i, it := range c.Item {
+			idx := i
+			chk := widget.NewCheck(it.Teks, nil)
+			chk.SetChecked(it.Selesai)
+			chk.OnChanged = func(v bool) {
+				c.Item[idx].Selesai = v
+				simpan()
+			}
+			btnDel := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				c.Item = append(c.Item[:idx], c.Item[idx+1:]...)
+				simpan()
+				refreshList()
+			})
+			btnDel.Importance = widget.LowImportance
+			list.Add(container.NewBorder(nil, nil, nil, btnDel, chk))
+		}
+		list.Refresh()
+	}
+	refreshList()
+
+	entryBaru := widget.NewEntry()
+	entryBaru.SetPlaceHolder("Tambah persiapan...")
+	tambah := func(string) {
+		teks := strings.TrimSpace(entryBaru.Text)
+		if teks == "" {
+			return
+		}
+		c.Item = append(c.Item, itemChecklist{Teks: teks})
+		entryBaru.SetText("")
+		simpan()
+		refreshList()
+	}
+	entryBaru.OnSubmitted = tambah
+	btnTambah := widget.NewButtonWithIcon("", theme.ContentAddIcon(), func() { tambah("") })
+
+	lblCatatan := canvas.NewText("Catatan:", ColorTextGrey)
+	lblCatatan.TextSize = 12
+	entryCatatan := widget.NewMultiLineEntry()
+	entryCatatan.Wrapping = fyne.TextWrapWord
+	entryCatatan.SetPlaceHolder("Catatan persiapan (opsional)")
+	entryCatatan.SetText(c.Catatan)
+	simpanCatatan := penunda(jedaSimpanCatatan, simpan)
+	entryCatatan.OnChanged = func(s string) {
+		c.Catatan = s
+		simpanCatatan()
+	}
+
+	body := container.NewVBox(
+		list,
+		container.NewBorder(nil, nil, nil, btnTambah, entryBaru),
+		widget.NewSeparator(),
+		lblCatatan,
+		entryCatatan,
+	)
+
+	btnReset := widget.NewButton("Template", func() {
+		catatan := c.Catatan
+		c = checklistBaru(acara)
+		c.Catatan = catatan
+		simpan()
+		refreshList()
+	})
+	btnReset.Importance = widget.LowImportance
+
+	showModalCard(parentCanvas, "Persiapan "+acara+" · "+pr.Nama, body, btnReset)
+}
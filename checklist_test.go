@@ -0,0 +1,36 @@
+package main
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestPenundaSekaliSetelahBerhenti(t *testing.T) {
+	test.NewApp()
+	var n atomic.Int32
+	picu := penunda(50*time.Millisecond, func() { n.Add(1) })
+
+	// Ketikan beruntun tidak langsung menyimpan.
+	for i := 0; i < 10; i++ {
+		picu()
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := n.Load(); got != 0 {
+		t.Fatalf("tersimpan %d kali saat masih mengetik, want 0", got)
+	}
+
+	time.Sleep(200 * time.Millisecond)
+	if got := n.Load(); got != 1 {
+		t.Fatalf("tersimpan %d kali setelah berhenti mengetik, want 1", got)
+	}
+
+	// Ketikan berikutnya memicu simpan baru.
+	picu()
+	time.Sleep(200 * time.Millisecond)
+	if got := n.Load(); got != 2 {
+		t.Fatalf("tersimpan %d kali setelah ketikan kedua, want 2", got)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ==========================================
+// TAMPILAN PENJELASAN (MARKDOWN)
+// ==========================================
+
+// judulSumber adalah judul bagian rujukan; bagian ini diberi latar tersendiri.
+const judulSumber = "Sumber"
+
+// bagianMarkdown adalah potongan Markdown yang diawali satu judul "## ".
+type bagianMarkdown struct {
+	Judul string // kosong untuk teks sebelum judul pertama
+	Isi   string // termasuk baris judulnya
+}
+
+// pecahBagian memotong Markdown di setiap judul "## " agar tiap bagian bisa
+// dituju dari navigasi.
+func pecahBagian(md string) []bagianMarkdown {
+	var hasil []bagianMarkdown
+	cur := bagianMarkdown{}
+	var baris []string
+	tutup := func() {
+		cur.Isi = strings.TrimSpace(strings.Join(baris, "\n"))
+		if cur.Isi != "" {
+			hasil = append(hasil, cur)
+		}
+	}
+	for _, l := range strings.Split(md, "\n") {
+		if judul, ok := strings.CutPrefix(l, "## "); ok {
+			tutup()
+			cur = bagianMarkdown{Judul: strings.TrimSpace(judul)}
+			baris = nil
+		}
+		baris = append(baris, l)
+	}
+	tutup()
+	return hasil
+}
+
+// createDeskripsiView menampilkan penjelasan Markdown sebagai RichText. Bila
+// ada beberapa judul bagian, di atasnya ditampilkan tombol untuk melompat ke
+// tiap bagian. Bagian "Sumber" diberi latar seperti kutipan.
+func createDeskripsiView(md string) fyne.CanvasObject {
+	daftar := pecahBagian(md)
+	box := container.NewVBox()
+	scroll := container.NewVScroll(box)
+	scroll.SetMinSize(fyne.NewSize(0, 300))
+
+	nav := container.NewHBox()
+	for _, b := range daftar {
+		rt := widget.NewRichTextFromMarkdown(b.Isi)
+		rt.Wrapping = fyne.TextWrapWord
+
+		var obj fyne.CanvasObject = rt
+		if b.Judul == judulSumber {
+			bg := canvas.NewRectangle(ColorBgDark)
+			bg.CornerRadius = 8
+			obj = container.NewStack(bg, rt)
+		}
+		box.Add(obj)
+
+		if b.Judul == "" {
+			continue
+		}
+		target := obj
+		btn := widget.NewButton(b.Judul, func() {
+			scroll.ScrollToOffset(fyne.NewPos(0, target.Position().Y))
+		})
+		btn.Importance = widget.LowImportance
+		nav.Add(btn)
+	}
+
+	if len(nav.Objects) < 3 {
+		return scroll
+	}
+	return container.NewBorder(container.NewHScroll(nav), nil, nil, nil, scroll)
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// EKSPOR JADWAL
+// ==========================================
+
+// jadwalEkspor adalah satu hasil perhitungan selamatan yang siap diekspor.
+type jadwalEkspor struct {
+	ProfilID string // ID profil tersimpan, kosong bila tanpa profil
+	Nama     string // nama almarhum/almarhumah, kosong bila tanpa profil
+	Geblag   time.Time
+	Kurup    jawa.Kurup
+	Pakem    string
+	Jadwal   []selamatan.Jadwal
+}
+
+// judul dipakai sebagai nama kalender dan judul pesan.
+func (e jadwalEkspor) judul() string {
+	if e.Nama != "" {
+		return "Selamatan " + e.Nama
+	}
+	return "Selamatan Geblag " + formatIndoDate(e.Geblag)
+}
+
+// slug mengubah teks menjadi huruf kecil dengan hanya a-z, 0-9 dan "-".
+func slug(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(s))
+}
+
+func (e jadwalEkspor) namaBerkas(ext string) string {
+	nama := e.Nama
+	if nama == "" {
+		nama = e.Geblag.Format("2006-01-02")
+	}
+	return "selamatan-" + slug(nama) + ext
+}
+
+// kunciUID membedakan almarhum yang geblagnya sama: ID profil bila ada,
+// selain itu hash nama dan tanggal geblag.
+func (e jadwalEkspor) kunciUID() string {
+	if e.ProfilID != "" {
+		return slug(e.ProfilID)
+	}
+	h := fnv.New32a()
+	h.Write([]byte(e.Nama + "|" + e.Geblag.Format("20060102")))
+	return fmt.Sprintf("%08x", h.Sum32())
+}
+
+// eventICS mengubah jadwal menjadi event iCalendar. UID dibentuk dari profil,
+// tanggal geblag dan nama acara agar impor ulang memperbarui event yang sama
+// tanpa menimpa jadwal almarhum lain yang geblagnya sama.
+func (e jadwalEkspor) eventICS() []selamatan.EventICS {
+	var hasil []selamatan.EventICS
+	for _, j := range e.Jadwal {
+		judul := j.Acara.Nama
+		if e.Nama != "" {
+			judul += " " + e.Nama
+		}
+		desk := []string{
+			j.Acara.Sub,
+			formatWeton(j.Tanggal, e.Kurup),
+			fmt.Sprintf("Rumus %s (%s)", j.Rumus, j.Rumus.Keterangan()),
+		}
+		if lain := formatTanggalLain(j); lain != "" {
+			desk = append(desk, lain)
+		}
+		desk = append(desk, "Geblag: "+formatIndoDate(e.Geblag))
+		uid := fmt.Sprintf("%s-%s-%s@kalender-selamatan", e.kunciUID(), e.Geblag.Format("20060102"), slug(j.Acara.Nama))
+		hasil = append(hasil, selamatan.EventICS{UID: uid, Judul: judul, Deskripsi: strings.Join(desk, "\n"), Tanggal: j.Tanggal})
+	}
+	return hasil
+}
+
+// alarmPengingat mengubah jarak hari pengingat menjadi waktu VALARM relatif
+// terhadap awal hari acara, dikirim pada jam pengingat.
+func alarmPengingat(hari []int) []time.Duration {
+	var hasil []time.Duration
+	for _, h := range hari {
+		hasil = append(hasil, -time.Duration(h)*24*time.Hour+jamPengingat)
+	}
+	return hasil
+}
+
+// showEksporICS menanyakan apakah pengingat ikut disertakan lalu menyimpan
+// jadwal sebagai berkas .ics.
+func showEksporICS(win fyne.Window, e jadwalEkspor, pengingat []int) {
+	chkAlarm := widget.NewCheck("Sertakan pengingat (sesuai pengaturan)", nil)
+	chkAlarm.SetChecked(len(pengingat) > 0)
+	if len(pengingat) == 0 {
+		chkAlarm.Disable()
+	}
+	note := widget.NewLabel("Berkas .ics bisa dibuka di Google Calendar, kalender ponsel maupun Outlook.")
+	note.Wrapping = fyne.TextWrapWord
+
+	dialog.ShowCustomConfirm("Ekspor Kalender", "Simpan", "Batal", container.NewVBox(note, chkAlarm), func(ok bool) {
+		if !ok {
+			return
+		}
+		var alarm []time.Duration
+		if chkAlarm.Checked {
+			alarm = alarmPengingat(pengingat)
+		}
+		save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil || w == nil {
+				return
+			}
+			defer w.Close()
+			if err := selamatan.TulisICS(w, e.judul(), e.eventICS(), alarm); err != nil {
+				dialog.ShowError(err, win)
+			}
+		}, win)
+		save.SetFileName(e.namaBerkas(".ics"))
+		save.Show()
+	}, win)
+}
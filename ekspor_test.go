@@ -0,0 +1,44 @@
+package main
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+func TestEventICSUID(t *testing.T) {
+	geblag := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	jadwal := selamatan.PakemBawaan()[0].Hitung(geblag, selamatan.Opsi{Kurup: jawa.Asapon})
+	pola := regexp.MustCompile(`^[a-z0-9-]+@kalender-selamatan$`)
+
+	a := jadwalEkspor{ProfilID: "lq3x9k", Nama: "Bapak Sastro (Mbah Kakung)", Geblag: geblag, Jadwal: jadwal}
+	b := jadwalEkspor{ProfilID: "lq3xa0", Nama: "Ibu Sastro", Geblag: geblag, Jadwal: jadwal}
+	c := jadwalEkspor{Nama: "Ibu Sastro", Geblag: geblag, Jadwal: jadwal}
+	d := jadwalEkspor{Nama: "Pak Darmo", Geblag: geblag, Jadwal: jadwal}
+
+	dipakai := map[string]bool{}
+	for _, e := range []jadwalEkspor{a, b, c, d} {
+		for _, ev := range e.eventICS() {
+			if !pola.MatchString(ev.UID) {
+				t.Errorf("UID %q mengandung karakter di luar [a-z0-9-]", ev.UID)
+			}
+			if dipakai[ev.UID] {
+				t.Errorf("UID %q dipakai dua kali", ev.UID)
+			}
+			dipakai[ev.UID] = true
+		}
+	}
+
+	// Ekspor ulang profil yang sama harus menghasilkan UID yang sama.
+	ulang := a
+	ulang.Nama = "Bapak Sastro"
+	if got, want := ulang.eventICS()[0].UID, a.eventICS()[0].UID; got != want {
+		t.Errorf("UID berubah setelah nama diganti: %q, want %q", got, want)
+	}
+	if got, want := c.eventICS()[0].UID, (jadwalEkspor{Nama: "Ibu Sastro", Geblag: geblag, Jadwal: jadwal}).eventICS()[0].UID; got != want {
+		t.Errorf("UID tanpa profil tidak stabil: %q vs %q", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"image"
+	"image/png"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/driver/software"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/widget"
+)
+
+// ==========================================
+// RENDER JADWAL KE GAMBAR PNG
+// ==========================================
+
+// ukuranGambar menentukan lebar tata letak (satuan Fyne) dan skala render.
+// Lebar piksel hasil adalah Lebar x Skala.
+type ukuranGambar struct {
+	Nama  string
+	Lebar float32
+	Skala float32
+}
+
+var DaftarUkuranGambar = []ukuranGambar{
+	{"Ponsel (1080 px)", 360, 3},
+	{"Cetak A4 300 dpi (2480 px)", 400, 6.2},
+}
+
+// createGambarJadwal menyusun isi gambar: judul, seluruh kartu acara dan
+// kredit. Kartu dibuat tanpa canvas sehingga tidak bisa diketuk.
+func createGambarJadwal(e jadwalEkspor, now time.Time) fyne.CanvasObject {
+	lblJudul := canvas.NewText(e.judul(), ColorTextWhite)
+	lblJudul.TextSize = 18
+	lblJudul.TextStyle = fyne.TextStyle{Bold: true}
+	lblJudul.Alignment = fyne.TextAlignCenter
+	lblGeblag := canvas.NewText("Geblag: "+formatIndoDate(e.Geblag)+", "+formatWeton(e.Geblag, e.Kurup), ColorTextWhite)
+	lblGeblag.TextSize = 12
+	lblGeblag.Alignment = fyne.TextAlignCenter
+	lblPakem := canvas.NewText("Pakem "+e.Pakem+" · "+formatKurup(e.Kurup), ColorTextWhite)
+	lblPakem.TextSize = 11
+	lblPakem.TextStyle = fyne.TextStyle{Italic: true}
+	lblPakem.Alignment = fyne.TextAlignCenter
+
+	header := container.NewStack(
+		canvas.NewHorizontalGradient(ColorHeaderTop, ColorHeaderBot),
+		container.NewPadded(container.NewVBox(lblJudul, lblGeblag, lblPakem)),
+	)
+
+	cards := container.NewVBox()
+	for _, j := range e.Jadwal {
+		cards.Add(createJadwalCard(j, e.Kurup, now, nil, nil))
+		cards.Add(layout.NewSpacer())
+	}
+
+	imgCredit := canvas.NewImageFromResource(fyne.NewStaticResource("rich.png", richPngData))
+	imgCredit.FillMode = canvas.ImageFillContain
+	imgCredit.SetMinSize(fyne.NewSize(150, 50))
+
+	bg := canvas.NewImageFromResource(fyne.NewStaticResource("bg.png", bgPngData))
+	bg.FillMode = canvas.ImageFillCover
+
+	return container.NewStack(
+		canvas.NewRectangle(ColorBgDark),
+		bg,
+		container.NewVBox(
+			header,
+			container.NewPadded(cards),
+			container.NewCenter(imgCredit),
+		),
+	)
+}
+
+// renderGambarJadwal menggambar jadwal ke image tanpa jendela, memakai
+// canvas perangkat lunak. Tinggi gambar mengikuti isi.
+func renderGambarJadwal(e jadwalEkspor, now time.Time, u ukuranGambar) image.Image {
+	isi := createGambarJadwal(e, now)
+	c := software.NewCanvas()
+	c.SetPadded(false)
+	c.SetScale(u.Skala)
+	c.SetContent(isi)
+	c.Resize(fyne.NewSize(u.Lebar, isi.MinSize().Height))
+	return c.Capture()
+}
+
+// showEksporGambar menanyakan ukuran gambar lalu menyimpannya sebagai PNG.
+func showEksporGambar(win fyne.Window, e jadwalEkspor) {
+	var nama []string
+	for _, u := range DaftarUkuranGambar {
+		nama = append(nama, u.Nama)
+	}
+	selUkuran := widget.NewRadioGroup(nama, nil)
+	selUkuran.SetSelected(nama[0])
+
+	dialog.ShowCustomConfirm("Simpan Gambar", "Simpan", "Batal", selUkuran, func(ok bool) {
+		if !ok {
+			return
+		}
+		u := DaftarUkuranGambar[0]
+		for i, n := range nama {
+			if n == selUkuran.Selected {
+				u = DaftarUkuranGambar[i]
+			}
+		}
+		now := time.Now()
+		now = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		img := renderGambarJadwal(e, now, u)
+
+		save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil || w == nil {
+				return
+			}
+			defer w.Close()
+			if err := png.Encode(w, img); err != nil {
+				dialog.ShowError(err, win)
+			}
+		}, win)
+		save.SetFileName(e.namaBerkas(".png"))
+		save.Show()
+	}, win)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+func TestRenderGambarJadwal(t *testing.T) {
+	test.NewApp()
+
+	geblag := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	o := selamatan.Opsi{Kurup: jawa.Asapon}
+	e := jadwalEkspor{
+		Nama:   "Bapak Sastro",
+		Geblag: geblag,
+		Kurup:  o.Kurup,
+		Pakem:  "Standar",
+		Jadwal: selamatan.PakemBawaan()[0].Hitung(geblag, o),
+	}
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	kosong := e
+	kosong.Jadwal = nil
+
+	for _, u := range DaftarUkuranGambar {
+		img := renderGambarJadwal(e, now, u)
+		b := img.Bounds()
+		if h := renderGambarJadwal(kosong, now, u).Bounds().Dy(); b.Dy() <= h {
+			t.Errorf("%s: tinggi %d px tidak melebihi gambar tanpa acara (%d px)", u.Nama, b.Dy(), h)
+		}
+		if want := int(math.Round(float64(u.Lebar * u.Skala))); b.Dx() != want {
+			t.Errorf("%s: lebar %d px, want %d", u.Nama, b.Dx(), want)
+		}
+		// Delapan kartu acara tidak mungkin muat di tinggi yang lebih kecil
+		// dari lebarnya.
+		if b.Dy() <= b.Dx() {
+			t.Errorf("%s: tinggi %d px tidak lebih dari lebar %d px", u.Nama, b.Dy(), b.Dx())
+		}
+
+		warna := map[color.RGBA]bool{}
+		for y := b.Min.Y; y < b.Max.Y; y += 7 {
+			for x := b.Min.X; x < b.Max.X; x += 7 {
+				r, g, bl, a := img.At(x, y).RGBA()
+				warna[color.RGBA{uint8(r >> 8), uint8(g >> 8), uint8(bl >> 8), uint8(a >> 8)}] = true
+			}
+		}
+		if len(warna) < 256 {
+			t.Errorf("%s: gambar kosong, hanya %d warna", u.Nama, len(warna))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package jawa
+
+import "fmt"
+
+// ==========================================
+// LAPISAN FORMAT TEKS
+// ==========================================
+
+// NamaHari mengembalikan nama saptawara, misalnya "Jumat".
+func (w Weton) NamaHari() string {
+	return NamaHari[w.Hari]
+}
+
+// NamaPasaran mengembalikan nama pancawara, misalnya "Kliwon".
+func (w Weton) NamaPasaran() string {
+	return NamaPasaran[w.Pasaran]
+}
+
+// String menghasilkan weton dalam bentuk "Jumat Kliwon".
+func (w Weton) String() string {
+	return w.NamaHari() + " " + w.NamaPasaran()
+}
+
+// NamaBulan mengembalikan nama bulan Jawa, atau "Unknown" bila di luar tabel.
+func (d JavaneseDate) NamaBulan() string {
+	if d.Bulan > 0 && d.Bulan < len(NamaBulan) {
+		return NamaBulan[d.Bulan]
+	}
+	return "Unknown"
+}
+
+// NamaTahun mengembalikan nama tahun dalam windu, misalnya "Alip".
+func (d JavaneseDate) NamaTahun() string {
+	return NamaTahun[d.TahunWindu()]
+}
+
+// NamaWindu mengembalikan nama windu, misalnya "Sancaya".
+func (d JavaneseDate) NamaWindu() string {
+	return NamaWindu[d.Windu()]
+}
+
+// String menghasilkan tanggal Jawa lengkap dalam bentuk "12 Sapar 1955".
+func (d JavaneseDate) String() string {
+	return fmt.Sprintf("%d %s %d", d.Tanggal, d.NamaBulan(), d.Tahun)
+}
+
+// NamaBulan mengembalikan nama bulan Hijriah, atau "Unknown" bila di luar tabel.
+func (d HijriDate) NamaBulan() string {
+	if d.Bulan > 0 && d.Bulan < len(NamaBulanHijriah) {
+		return NamaBulanHijriah[d.Bulan]
+	}
+	return "Unknown"
+}
+
+// String menghasilkan tanggal Hijriah dalam bentuk "12 Safar 1447 H".
+func (d HijriDate) String() string {
+	return fmt.Sprintf("%d %s %d H", d.Tanggal, d.NamaBulan(), d.Tahun)
+}
+
+// String mengembalikan nama wuku, misalnya "Sinta".
+func (w Wuku) String() string {
+	return NamaWuku[w]
+}
+
+// NamaMangsa mengembalikan nama mangsa, misalnya "Kasa".
+func (m Mangsa) NamaMangsa() string {
+	return NamaMangsa[m.Ke-1]
+}
+
+// String menghasilkan mangsa dalam bentuk "Kasa (hari ke-5 dari 41)".
+func (m Mangsa) String() string {
+	return fmt.Sprintf("%s (hari ke-%d dari %d)", m.NamaMangsa(), m.Hari, m.Panjang)
+}
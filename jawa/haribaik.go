@@ -0,0 +1,101 @@
+package jawa
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ==========================================
+// PENCARIAN HARI BAIK
+// ==========================================
+
+// Hajat adalah jenis keperluan yang dicarikan hari baiknya.
+type Hajat int
+
+const (
+	HajatNikah Hajat = iota
+	HajatPindahRumah
+	HajatMulaiUsaha
+)
+
+var NamaHajat = []string{"Pernikahan", "Pindah Rumah", "Memulai Usaha"}
+
+func (h Hajat) String() string {
+	return NamaHajat[h]
+}
+
+// KriteriaHariBaik adalah masukan pencarian hari baik.
+type KriteriaHariBaik struct {
+	Hajat  Hajat
+	Weton  Weton   // weton orang yang punya hajat
+	Geblag []Weton // weton geblag orang tua, pantang dipakai untuk hajat
+	Kurup  Kurup
+}
+
+// HariBaik adalah penilaian satu tanggal.
+type HariBaik struct {
+	Tanggal   time.Time
+	Weton     Weton
+	Skor      int
+	Alasan    []string
+	Pantangan bool // jatuh pada hari pantangan, tidak disarankan sama sekali
+}
+
+// skorPancasuda berindeks sisa bagi 5 (urutan sama dengan ramalanLima).
+var skorPancasuda = []int{-3, 3, 2, 2, -2}
+
+// NilaiHariBaik menilai tanggal t untuk kriteria k. Penilaian memakai:
+//   - pancasuda: neptu hari itu ditambah neptu weton pemilik hajat dibagi 5
+//     (Sri, Lungguh, Gedhong baik; Lara, Pati buruk),
+//   - kesamaan dengan weton sendiri (hari lahir dianggap kuat untuk memulai),
+//   - bulan Suro yang dihindari untuk nikah dan pindah rumah,
+//   - geblag orang tua yang pantang dipakai untuk hajat apa pun.
+func NilaiHariBaik(t time.Time, k KriteriaHariBaik) HariBaik {
+	w := WetonOf(t)
+	h := HariBaik{Tanggal: t, Weton: w}
+
+	total := w.Neptu() + k.Weton.Neptu()
+	r := ramalanLima[total%5]
+	h.Skor += skorPancasuda[total%5]
+	h.Alasan = append(h.Alasan, fmt.Sprintf("Neptu %s (%d) + weton Anda %s (%d) = %d, dibagi 5 sisa %d: %s. %s",
+		w, w.Neptu(), k.Weton, k.Weton.Neptu(), total, total%5, r.nama, r.keterangan))
+
+	if w.Hari == k.Weton.Hari && w.Pasaran == k.Weton.Pasaran {
+		h.Skor++
+		h.Alasan = append(h.Alasan, "Bertepatan dengan weton Anda sendiri.")
+	}
+
+	if k.Hajat != HajatMulaiUsaha && JavaneseDateOf(t, k.Kurup).Bulan == 1 {
+		h.Skor -= 3
+		h.Alasan = append(h.Alasan, "Jatuh di bulan Suro yang lazim dihindari untuk "+k.Hajat.String()+".")
+	}
+
+	for _, g := range k.Geblag {
+		if w.Hari == g.Hari && w.Pasaran == g.Pasaran {
+			h.Pantangan = true
+			h.Alasan = append(h.Alasan, "Bertepatan dengan geblag orang tua ("+g.String()+"), pantang untuk hajat.")
+			break
+		}
+	}
+	return h
+}
+
+// CariHariBaik menilai setiap tanggal dari dari sampai sampai (inklusif) dan
+// mengembalikan tanggal yang disarankan, diurutkan dari skor tertinggi.
+// Tanggal pantangan dan yang skornya negatif tidak ikut.
+func CariHariBaik(dari, sampai time.Time, k KriteriaHariBaik) []HariBaik {
+	dari = time.Date(dari.Year(), dari.Month(), dari.Day(), 0, 0, 0, 0, dari.Location())
+	var hasil []HariBaik
+	for t := dari; !t.After(sampai); t = t.AddDate(0, 0, 1) {
+		h := NilaiHariBaik(t, k)
+		if h.Pantangan || h.Skor < 0 {
+			continue
+		}
+		hasil = append(hasil, h)
+	}
+	sort.SliceStable(hasil, func(i, j int) bool {
+		return hasil[i].Skor > hasil[j].Skor
+	})
+	return hasil
+}
@@ -0,0 +1,155 @@
+package jawa
+
+// ==========================================
+// PETUNGAN WETON JODOH
+// ==========================================
+
+// Petungan adalah hasil satu metode hitungan kecocokan weton.
+type Petungan struct {
+	Metode     string
+	Sisa       []int // sisa pembagian yang menentukan hasil
+	Nama       string
+	Baik       bool
+	Keterangan string
+}
+
+type ramalan struct {
+	nama       string
+	baik       bool
+	keterangan string
+}
+
+// ramalanDelapan berindeks sisa bagi 8 dari jumlah neptu (0 = Pesthi).
+var ramalanDelapan = []ramalan{
+	{"Pesthi", true, "Rumah tangga rukun, tenteram dan damai sampai tua. Masalah yang datang tidak sampai merusak keharmonisan."},
+	{"Pegat", false, "Sering menemui masalah, baik soal ekonomi, kekuasaan maupun orang ketiga, yang bisa berujung perpisahan."},
+	{"Ratu", true, "Pasangan yang dihormati dan disegani tetangga maupun kerabat, seperti raja dan ratu."},
+	{"Jodoh", true, "Benar-benar berjodoh. Saling menerima kelebihan dan kekurangan sehingga rukun sampai tua."},
+	{"Topo", true, "Di awal pernikahan sering susah, terutama ekonomi, namun akan bahagia setelah melewati masa sulit."},
+	{"Tinari", true, "Mudah mencari rezeki dan sering mendapat keberuntungan, hidup berkecukupan."},
+	{"Padu", false, "Sering bertengkar dan berselisih paham, meskipun biasanya tidak sampai bercerai."},
+	{"Sujanan", false, "Sering bertengkar dan rawan masalah perselingkuhan. Perlu saling menjaga kepercayaan."},
+}
+
+// ramalanTujuh berindeks sisa bagi 7 dari jumlah neptu (0 = Lebu Katiup Angin).
+var ramalanTujuh = []ramalan{
+	{"Lebu Katiup Angin", false, "Seperti debu tertiup angin: sulit mencapai cita-cita dan sering berpindah tempat tinggal."},
+	{"Wasesa Segara", true, "Berbudi luas dan pemaaf seperti samudra, berwibawa dan dihormati."},
+	{"Tunggak Semi", true, "Seperti tunggul yang bersemi: rezeki lancar, bila jatuh cepat bangkit kembali."},
+	{"Satriya Wibawa", true, "Mendapat kemuliaan, keluhuran dan dihormati banyak orang."},
+	{"Sumur Sinaba", true, "Seperti sumur yang didatangi orang: berilmu dan menjadi tempat bertanya."},
+	{"Satriya Wirang", false, "Sering menanggung malu dan kesedihan. Perlu banyak bersabar dan berdoa."},
+	{"Bumi Kapetak", false, "Harus bekerja keras dan banyak menanggung susah, namun tabah dan teguh."},
+}
+
+// ramalanLima berindeks sisa bagi 5 dari jumlah neptu (0 = Pati).
+var ramalanLima = []ramalan{
+	{"Pati", false, "Banyak halangan dan kesialan dalam rumah tangga. Dianjurkan memperbanyak sedekah dan doa."},
+	{"Sri", true, "Rezeki melimpah dan hidup bahagia."},
+	{"Lungguh", true, "Memperoleh derajat dan kedudukan yang baik."},
+	{"Gedhong", true, "Mampu mengumpulkan harta dan hidup berkecukupan."},
+	{"Lara", false, "Sering sakit-sakitan atau dilanda kesusahan."},
+}
+
+// ramalanJumlahSembilan berindeks sisa bagi 9 dari jumlah neptu keduanya
+// (sisa 0 dihitung 9, jadi indeks 0 tidak dipakai).
+var ramalanJumlahSembilan = []ramalan{
+	{},
+	{"Dikasihi", true, "Pasangan dikasihi dan disayangi orang banyak."},
+	{"Selamat", true, "Rumah tangga selamat dan banyak rezeki."},
+	{"Cepat wafat", false, "Salah satu pasangan dikhawatirkan berumur pendek."},
+	{"Banyak godaan", false, "Rumah tangga banyak digoda orang ketiga."},
+	{"Cepat cerai", false, "Rawan perpisahan dalam waktu dekat."},
+	{"Cepat kaya", true, "Cepat mendapat kemakmuran."},
+	{"Banyak musuh", false, "Sering berselisih dengan orang lain."},
+	{"Sengsara", false, "Banyak kesusahan dalam rumah tangga."},
+	{"Pengayom", true, "Menjadi tempat berlindung keluarga dan orang banyak."},
+}
+
+// ramalanSembilan berindeks [sisa kecil-1][sisa besar-1] dari neptu masing-masing
+// dibagi 9 (sisa 0 dihitung 9), menurut tabel Primbon Betaljemur. Diisi di init.
+var ramalanSembilan [9][9]ramalan
+
+func init() {
+	isi := func(a, b int, nama string, baik bool, ket string) {
+		ramalanSembilan[a-1][b-1] = ramalan{nama, baik, ket}
+	}
+	isi(1, 1, "Baik", true, "Dikasihi orang banyak.")
+	isi(1, 2, "Baik", true, "Hidup rukun.")
+	isi(1, 3, "Kuat", true, "Rumah tangga kuat dan rezeki lancar.")
+	isi(1, 4, "Banyak celaka", false, "Sering tertimpa musibah.")
+	isi(1, 5, "Cerai", false, "Rawan perpisahan.")
+	isi(1, 6, "Jauh rezeki", false, "Rezeki sulit didapat.")
+	isi(1, 7, "Banyak musuh", false, "Sering berselisih dengan orang lain.")
+	isi(1, 8, "Sengsara", false, "Banyak kesusahan.")
+	isi(1, 9, "Pengayom", true, "Menjadi tempat berlindung banyak orang.")
+	isi(2, 2, "Selamat", true, "Selamat dan banyak rezeki.")
+	isi(2, 3, "Salah satu cepat wafat", false, "Salah satu pasangan dikhawatirkan berumur pendek.")
+	isi(2, 4, "Banyak godaan", false, "Rumah tangga banyak digoda orang ketiga.")
+	isi(2, 5, "Banyak celaka", false, "Sering tertimpa musibah.")
+	isi(2, 6, "Cepat kaya", true, "Cepat mendapat kemakmuran.")
+	isi(2, 7, "Anak banyak yang mati", false, "Keturunan sering tertimpa musibah.")
+	isi(2, 8, "Dekat rezeki", true, "Rezeki mudah datang.")
+	isi(2, 9, "Banyak rezeki", true, "Rezeki melimpah.")
+	isi(3, 3, "Melarat", false, "Hidup kekurangan.")
+	isi(3, 4, "Banyak celaka", false, "Sering tertimpa musibah.")
+	isi(3, 5, "Cepat cerai", false, "Rawan perpisahan dalam waktu dekat.")
+	isi(3, 6, "Mendapat anugerah", true, "Mendapat kemuliaan dan anugerah.")
+	isi(3, 7, "Banyak celaka", false, "Sering tertimpa musibah.")
+	isi(3, 8, "Salah satu cepat wafat", false, "Salah satu pasangan dikhawatirkan berumur pendek.")
+	isi(3, 9, "Banyak rezeki", true, "Rezeki melimpah.")
+	isi(4, 4, "Sering sakit", false, "Sering dilanda penyakit.")
+	isi(4, 5, "Banyak godaan", false, "Rumah tangga banyak digoda.")
+	isi(4, 6, "Banyak rezeki", true, "Rezeki melimpah.")
+	isi(4, 7, "Melarat", false, "Hidup kekurangan.")
+	isi(4, 8, "Banyak halangan", false, "Usaha sering terhalang.")
+	isi(4, 9, "Salah satu kalah", false, "Salah satu pasangan selalu mengalah atau kalah.")
+	isi(5, 5, "Terus beruntung", true, "Selalu mendapat keberuntungan.")
+	isi(5, 6, "Rezeki terbatas", false, "Rezeki cukup namun terbatas.")
+	isi(5, 7, "Rezeki tak putus", true, "Rezeki terus mengalir.")
+	isi(5, 8, "Banyak halangan", false, "Usaha sering terhalang.")
+	isi(5, 9, "Rezeki mudah", true, "Mudah mendapat rezeki.")
+	isi(6, 6, "Banyak celaka", false, "Sering tertimpa musibah.")
+	isi(6, 7, "Rukun", true, "Hidup rukun dan damai.")
+	isi(6, 8, "Banyak musuh", false, "Sering berselisih dengan orang lain.")
+	isi(6, 9, "Sengsara", false, "Banyak kesusahan.")
+	isi(7, 7, "Dikuasai pasangan", false, "Salah satu pasangan selalu dikuasai yang lain.")
+	isi(7, 8, "Celaka karena diri sendiri", false, "Kesusahan datang dari ulah sendiri.")
+	isi(7, 9, "Langgeng", true, "Rumah tangga langgeng sampai tua.")
+	isi(8, 8, "Dikasihi orang", true, "Disayangi dan dihormati banyak orang.")
+	isi(8, 9, "Banyak celaka", false, "Sering tertimpa musibah.")
+	isi(9, 9, "Sulit rezeki", false, "Rezeki sulit didapat.")
+}
+
+func (r ramalan) petungan(metode string, sisa ...int) Petungan {
+	return Petungan{Metode: metode, Sisa: sisa, Nama: r.nama, Baik: r.baik, Keterangan: r.keterangan}
+}
+
+// sisaSembilan adalah sisa bagi 9 dengan sisa 0 dihitung 9.
+func sisaSembilan(n int) int {
+	if s := n % 9; s != 0 {
+		return s
+	}
+	return 9
+}
+
+// CekJodoh menghitung kecocokan dua weton dengan petungan yang lazim dipakai:
+// jumlah neptu keduanya dibagi 9, dibagi 8 (Pegat, Ratu, Jodoh, Topo, Tinari,
+// Padu, Sujanan, Pesthi), dibagi 7 dan dibagi 5. Sebagai bacaan kedua
+// disertakan tabel Betaljemur yang membagi 9 neptu masing-masing lalu
+// mencocokkan pasangan sisanya.
+func CekJodoh(a, b Weton) []Petungan {
+	total := a.Neptu() + b.Neptu()
+
+	sa, sb := sisaSembilan(a.Neptu()), sisaSembilan(b.Neptu())
+	if sa > sb {
+		sa, sb = sb, sa
+	}
+	return []Petungan{
+		ramalanJumlahSembilan[sisaSembilan(total)].petungan("Dibagi 9", sisaSembilan(total)),
+		ramalanDelapan[total%8].petungan("Pegat-Pesthi (dibagi 8)", total%8),
+		ramalanTujuh[total%7].petungan("Dibagi 7", total%7),
+		ramalanLima[total%5].petungan("Dibagi 5", total%5),
+		ramalanSembilan[sa-1][sb-1].petungan("Neptu masing-masing dibagi 9", sa, sb),
+	}
+}
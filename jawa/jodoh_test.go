@@ -0,0 +1,67 @@
+package jawa
+
+import "testing"
+
+func TestCekJodoh(t *testing.T) {
+	type hasil struct {
+		nama string
+		sisa []int
+	}
+	tests := []struct {
+		wa   Weton
+		wb   Weton
+		want []hasil // dibagi 9, 8, 7, 5, lalu pasangan sisa 9
+	}{
+		{
+			// Jumat Legi (11) + Sabtu Legi (14) = 25
+			wa: WetonOf(tanggal(1945, 8, 17)), wb: WetonOf(tanggal(2000, 1, 1)),
+			want: []hasil{{"Banyak musuh", []int{7}}, {"Pegat", []int{1}}, {"Sumur Sinaba", []int{4}}, {"Pati", []int{0}}, {"Banyak celaka", []int{2, 5}}},
+		},
+		{
+			// Selasa Pon (10) + Rabu Wage (11) = 21
+			wa: WetonOf(tanggal(2021, 8, 10)), wb: WetonOf(tanggal(2021, 8, 11)),
+			want: []hasil{{"Cepat wafat", []int{3}}, {"Tinari", []int{5}}, {"Lebu Katiup Angin", []int{0}}, {"Sri", []int{1}}, {"Baik", []int{1, 2}}},
+		},
+		{
+			// Senin Pahing (13) + Jumat Legi (11) = 24
+			wa: WetonOf(tanggal(2021, 8, 9)), wb: WetonOf(tanggal(1945, 8, 17)),
+			want: []hasil{{"Cepat kaya", []int{6}}, {"Pesthi", []int{0}}, {"Satriya Wibawa", []int{3}}, {"Lara", []int{4}}, {"Banyak godaan", []int{2, 4}}},
+		},
+		{
+			// Sabtu Pahing (18) + Sabtu Pahing (18) = 36, sisa 0 dihitung 9
+			wa: WetonOf(tanggal(2000, 1, 22)), wb: WetonOf(tanggal(2000, 1, 22)),
+			want: []hasil{{"Pengayom", []int{9}}, {"Topo", []int{4}}, {"Wasesa Segara", []int{1}}, {"Sri", []int{1}}, {"Sulit rezeki", []int{9, 9}}},
+		},
+	}
+	for _, tt := range tests {
+		got := CekJodoh(tt.wa, tt.wb)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s + %s: %d petungan, want %d", tt.wa, tt.wb, len(got), len(tt.want))
+		}
+		for i, w := range tt.want {
+			if got[i].Nama != w.nama || !samaSisa(got[i].Sisa, w.sisa) {
+				t.Errorf("%s + %s, %s = %s sisa %v, want %s sisa %v",
+					tt.wa, tt.wb, got[i].Metode, got[i].Nama, got[i].Sisa, w.nama, w.sisa)
+			}
+		}
+		// Urutan weton tidak boleh mengubah hasil.
+		balik := CekJodoh(tt.wb, tt.wa)
+		for i := range got {
+			if balik[i].Nama != got[i].Nama {
+				t.Errorf("%s + %s, %s tidak simetris: %s vs %s", tt.wa, tt.wb, got[i].Metode, got[i].Nama, balik[i].Nama)
+			}
+		}
+	}
+}
+
+func samaSisa(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Package jawa berisi mesin hitung kalender Jawa: saptawara (hari),
+// pancawara (pasaran), neptu dan penanggalan Jawa.
+//
+// Paket ini sengaja tidak mengenal UI maupun bahasa tampilan. Semua fungsi
+// mengembalikan nilai bertipe (indeks, angka) sehingga bisa dipakai ulang oleh
+// aplikasi lain; teks siap tampil disediakan terpisah lewat method String.
+package jawa
+
+import "time"
+
+// ==========================================
+// TABEL DASAR
+// ==========================================
+
+var (
+	NamaHari         = []string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+	NamaPasaran      = []string{"Legi", "Pahing", "Pon", "Wage", "Kliwon"}
+	NamaBulan        = []string{"", "Suro", "Sapar", "Mulud", "Bakda Mulud", "Jumadil Awal", "Jumadil Akhir", "Rajeb", "Ruwah", "Poso", "Sawal", "Sela", "Besar"}
+	NamaTahun        = []string{"Alip", "Ehe", "Jimawal", "Je", "Dal", "Be", "Wawu", "Jimakir"}
+	NamaWindu        = []string{"Adi", "Kuntara", "Sengara", "Sancaya"}
+	NamaBulanHijriah = []string{"", "Muharram", "Safar", "Rabiul Awal", "Rabiul Akhir", "Jumadil Awal", "Jumadil Akhir", "Rajab", "Syaban", "Ramadhan", "Syawal", "Dzulqaidah", "Dzulhijjah"}
+	NilaiHari        = []int{5, 4, 3, 7, 8, 6, 9}
+	NilaiPasaran     = []int{5, 9, 7, 4, 8}
+)
+
+// ==========================================
+// JULIAN DAY NUMBER
+// ==========================================
+
+// DateToJDN mengubah tanggal Masehi menjadi Julian Day Number. Jam diabaikan,
+// yang dipakai hanya tanggal kalendernya.
+func DateToJDN(t time.Time) int {
+	a := (14 - int(t.Month())) / 12
+	y := t.Year() + 4800 - a
+	m := int(t.Month()) + 12*a - 3
+	return t.Day() + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// ==========================================
+// WETON
+// ==========================================
+
+// Weton adalah pasangan saptawara dan pancawara sebuah hari beserta neptunya.
+type Weton struct {
+	Hari         int // indeks NamaHari, 0 = Minggu (sama dengan time.Weekday)
+	Pasaran      int // indeks NamaPasaran, 0 = Legi
+	NeptuHari    int
+	NeptuPasaran int
+}
+
+// NewWeton menyusun Weton dari indeks hari dan pasaran.
+func NewWeton(hari, pasaran int) Weton {
+	return Weton{
+		Hari:         hari,
+		Pasaran:      pasaran,
+		NeptuHari:    NilaiHari[hari],
+		NeptuPasaran: NilaiPasaran[pasaran],
+	}
+}
+
+// WetonOf menghitung weton untuk tanggal t.
+func WetonOf(t time.Time) Weton {
+	return NewWeton(int(t.Weekday()), DateToJDN(t)%5)
+}
+
+// Neptu adalah jumlah neptu hari dan neptu pasaran.
+func (w Weton) Neptu() int {
+	return w.NeptuHari + w.NeptuPasaran
+}
+
+// ==========================================
+// PENANGGALAN JAWA
+// ==========================================
+
+// JavaneseDate adalah tanggal dalam kalender Jawa.
+type JavaneseDate struct {
+	Tanggal int
+	Bulan   int // indeks NamaBulan, 1 = Suro
+	Tahun   int // tahun Jawa (Anno Javanico)
+}
+
+// TahunWindu mengembalikan indeks NamaTahun, yaitu urutan tahun di dalam
+// windu (siklus 8 tahun). Tahun 1955 adalah Alip.
+func (d JavaneseDate) TahunWindu() int {
+	return (d.Tahun + 5) % 8
+}
+
+// Windu mengembalikan indeks NamaWindu (siklus 4 windu, 32 tahun). Windu
+// 1955-1962 adalah Sancaya.
+func (d JavaneseDate) Windu() int {
+	return ((d.Tahun+5)/8 + 2) % 4
+}
+
+// ==========================================
+// PENANGGALAN HIJRIAH
+// ==========================================
+
+// HijriDate adalah tanggal dalam kalender Hijriah tabular.
+type HijriDate struct {
+	Tanggal int
+	Bulan   int // 1 = Muharram
+	Tahun   int
+}
+
+// HijriDateOf menghitung tanggal Hijriah tabular (sipil) untuk tanggal t.
+// Hasilnya bisa selisih sehari dengan rukyat maupun kalender Jawa.
+func HijriDateOf(t time.Time) HijriDate {
+	jd := DateToJDN(t)
+	l := jd - 1948440 + 10632
+	n := (l - 1) / 10631
+	l = l - 10631*n + 354
+	j := ((10985-l)/5316)*((50*l)/17719) + (l/5670)*((43*l)/15238)
+	l = l - ((30-j)/15)*((17719*j)/50) - (j/16)*((15238*j)/43) + 29
+	hm := (24 * l) / 709
+	hd := l - (709*hm)/24
+	hy := 30*n + j - 30
+	return HijriDate{Tanggal: hd, Bulan: hm, Tahun: hy}
+}
@@ -0,0 +1,173 @@
+package jawa
+
+import (
+	"testing"
+	"time"
+)
+
+func tanggal(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestDateToJDN(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want int
+	}{
+		{tanggal(2000, 1, 1), 2451545}, // J2000
+		{tanggal(1945, 8, 17), 2431685},
+		{tanggal(2021, 8, 10), jdnPatokanAsapon},
+		{tanggal(1900, 3, 1), 2415080},
+	}
+	for _, tt := range tests {
+		if got := DateToJDN(tt.t); got != tt.want {
+			t.Errorf("DateToJDN(%s) = %d, want %d", tt.t.Format("2006-01-02"), got, tt.want)
+		}
+		if got := JDNToDate(tt.want, time.UTC); !got.Equal(tt.t) {
+			t.Errorf("JDNToDate(%d) = %s, want %s", tt.want, got.Format("2006-01-02"), tt.t.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestWetonOf(t *testing.T) {
+	tests := []struct {
+		t     time.Time
+		want  string
+		neptu int
+	}{
+		{tanggal(1945, 8, 17), "Jumat Legi", 11},
+		{tanggal(2000, 1, 1), "Sabtu Legi", 14},
+		{tanggal(2021, 8, 10), "Selasa Pon", 10},
+		{tanggal(2021, 8, 11), "Rabu Wage", 11},
+		{tanggal(2021, 8, 9), "Senin Pahing", 13},
+	}
+	for _, tt := range tests {
+		w := WetonOf(tt.t)
+		if w.String() != tt.want || w.Neptu() != tt.neptu {
+			t.Errorf("WetonOf(%s) = %s neptu %d, want %s neptu %d",
+				tt.t.Format("2006-01-02"), w, w.Neptu(), tt.want, tt.neptu)
+		}
+	}
+}
+
+// Nama kurup diambil dari weton 1 Suro tahun Alip, dan sewindu tepat 405
+// minggu dan 567 pasaran, jadi setiap 1 Suro Alip harus jatuh pada weton
+// yang sama dengan nama kurupnya.
+func TestSatuSuroAlip(t *testing.T) {
+	want := map[Kurup]string{
+		Aboge:  "Rabu Wage",
+		Asapon: "Selasa Pon",
+		Anenge: "Senin Pahing",
+	}
+	for _, k := range DaftarKurup {
+		for tahun := 1891; tahun <= 2043; tahun += 8 {
+			d := JavaneseDate{Tanggal: 1, Bulan: 1, Tahun: tahun}
+			if d.NamaTahun() != "Alip" {
+				t.Fatalf("tahun %d bukan Alip: %s", tahun, d.NamaTahun())
+			}
+			tgl, err := JavaneseToDate(d, k, time.UTC)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := WetonOf(tgl).String(); got != want[k] {
+				t.Errorf("%s: 1 Suro %d = %s (%s), want %s", k, tahun, got, tgl.Format("2006-01-02"), want[k])
+			}
+		}
+	}
+}
+
+func TestSatuSuroPerKurup(t *testing.T) {
+	tests := []struct {
+		tahun int
+		k     Kurup
+		want  time.Time
+	}{
+		{1955, Asapon, tanggal(2021, 8, 10)},
+		{1955, Aboge, tanggal(2021, 8, 11)},
+		{1955, Anenge, tanggal(2021, 8, 9)},
+		{1956, Asapon, tanggal(2022, 7, 30)}, // 1955 Alip 354 hari
+		{1957, Asapon, tanggal(2023, 7, 20)}, // 1956 Ehe wuntu 355 hari
+	}
+	for _, tt := range tests {
+		got, err := JavaneseToDate(JavaneseDate{Tanggal: 1, Bulan: 1, Tahun: tt.tahun}, tt.k, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("1 Suro %d %s = %s, want %s", tt.tahun, tt.k, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestJavaneseRoundTrip(t *testing.T) {
+	for _, k := range DaftarKurup {
+		prev := JavaneseDateOf(tanggal(1899, 12, 31), k)
+		for d := tanggal(1900, 1, 1); d.Year() < 2101; d = d.AddDate(0, 0, 1) {
+			j := JavaneseDateOf(d, k)
+			got, err := JavaneseToDate(j, k, time.UTC)
+			if err != nil {
+				t.Fatalf("%s %s: %v", k, j, err)
+			}
+			if !got.Equal(d) {
+				t.Fatalf("%s: %s -> %s -> %s", k, d.Format("2006-01-02"), j, got.Format("2006-01-02"))
+			}
+
+			// Tanggal Jawa harus maju tepat satu hari.
+			switch {
+			case j.Tanggal == prev.Tanggal+1 && j.Bulan == prev.Bulan && j.Tahun == prev.Tahun:
+			case j.Tanggal == 1 && prev.Tanggal == PanjangBulan(prev.Tahun, prev.Bulan) &&
+				((j.Bulan == prev.Bulan+1 && j.Tahun == prev.Tahun) || (j.Bulan == 1 && prev.Bulan == 12 && j.Tahun == prev.Tahun+1)):
+			default:
+				t.Fatalf("%s: %s lalu %s tidak berurutan", k, prev, j)
+			}
+			prev = j
+		}
+	}
+}
+
+func TestJavaneseToDateInvalid(t *testing.T) {
+	tests := []JavaneseDate{
+		{Tanggal: 30, Bulan: 2, Tahun: 1955},  // Sapar 29 hari
+		{Tanggal: 30, Bulan: 12, Tahun: 1955}, // Besar tahun Alip 29 hari
+		{Tanggal: 1, Bulan: 13, Tahun: 1955},
+		{Tanggal: 0, Bulan: 1, Tahun: 1955},
+	}
+	for _, d := range tests {
+		if _, err := JavaneseToDate(d, Asapon, time.UTC); err == nil {
+			t.Errorf("JavaneseToDate(%+v) tanpa error", d)
+		}
+	}
+	if _, err := JavaneseToDate(JavaneseDate{Tanggal: 30, Bulan: 12, Tahun: 1956}, Asapon, time.UTC); err != nil {
+		t.Errorf("30 Besar 1956 (wuntu) seharusnya ada: %v", err)
+	}
+}
+
+func TestPanjangTahun(t *testing.T) {
+	total := 0
+	for tahun := 1955; tahun < 1963; tahun++ {
+		total += PanjangTahun(tahun)
+		bulan := 0
+		for b := 1; b <= 12; b++ {
+			bulan += PanjangBulan(tahun, b)
+		}
+		if bulan != PanjangTahun(tahun) {
+			t.Errorf("tahun %d: jumlah bulan %d, panjang tahun %d", tahun, bulan, PanjangTahun(tahun))
+		}
+	}
+	if total != hariSewindu {
+		t.Errorf("sewindu = %d hari, want %d", total, hariSewindu)
+	}
+}
+
+func TestHijriRoundTrip(t *testing.T) {
+	for d := tanggal(1950, 1, 1); d.Year() < 2050; d = d.AddDate(0, 0, 1) {
+		h := HijriDateOf(d)
+		got, err := HijriToDate(h, time.UTC)
+		if err != nil {
+			t.Fatalf("%s: %v", h, err)
+		}
+		if !got.Equal(d) {
+			t.Fatalf("%s -> %s -> %s", d.Format("2006-01-02"), h, got.Format("2006-01-02"))
+		}
+	}
+}
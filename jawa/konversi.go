@@ -0,0 +1,61 @@
+package jawa
+
+import (
+	"fmt"
+	"time"
+)
+
+// ==========================================
+// KONVERSI BALIK KE MASEHI
+// ==========================================
+
+// JDNToDate mengubah Julian Day Number menjadi tanggal Masehi (jam 00.00)
+// pada zona waktu loc.
+func JDNToDate(jdn int, loc *time.Location) time.Time {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := 100*b + d - 4800 + m/10
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// JavaneseToDate mengubah tanggal Jawa menurut kurup k menjadi tanggal Masehi.
+// Tanggal yang tidak ada (misalnya 30 Sapar) menghasilkan error.
+func JavaneseToDate(d JavaneseDate, k Kurup, loc *time.Location) (time.Time, error) {
+	if d.Bulan < 1 || d.Bulan > jumlahBulan {
+		return time.Time{}, fmt.Errorf("bulan Jawa tidak valid: %d", d.Bulan)
+	}
+	if d.Tanggal < 1 || d.Tanggal > PanjangBulan(d.Tahun, d.Bulan) {
+		return time.Time{}, fmt.Errorf("tanggal %d tidak ada di bulan %s %d", d.Tanggal, d.NamaBulan(), d.Tahun)
+	}
+
+	windu := floorDiv(d.Tahun-tahunPatokan, 8)
+	hari := windu * hariSewindu
+	for tahun := tahunPatokan + windu*8; tahun < d.Tahun; tahun++ {
+		hari += PanjangTahun(tahun)
+	}
+	for bulan := 1; bulan < d.Bulan; bulan++ {
+		hari += PanjangBulan(d.Tahun, bulan)
+	}
+	hari += d.Tanggal - 1
+	return JDNToDate(k.jdnPatokan()+hari, loc), nil
+}
+
+// HijriToDate mengubah tanggal Hijriah tabular menjadi tanggal Masehi. Ini
+// kebalikan dari HijriDateOf.
+func HijriToDate(d HijriDate, loc *time.Location) (time.Time, error) {
+	if d.Bulan < 1 || d.Bulan > 12 || d.Tanggal < 1 || d.Tanggal > 30 || d.Tahun < 1 {
+		return time.Time{}, fmt.Errorf("tanggal Hijriah tidak valid: %d-%d-%d", d.Tanggal, d.Bulan, d.Tahun)
+	}
+	jdn := (11*d.Tahun+3)/30 + 354*d.Tahun + 30*d.Bulan - (d.Bulan-1)/2 + d.Tanggal + 1948440 - 385
+	t := JDNToDate(jdn, loc)
+	if HijriDateOf(t) != d {
+		return time.Time{}, fmt.Errorf("tanggal %d tidak ada di bulan %s %d H", d.Tanggal, d.NamaBulan(), d.Tahun)
+	}
+	return t, nil
+}
@@ -0,0 +1,109 @@
+package jawa
+
+import "time"
+
+// ==========================================
+// KURUP (SIKLUS 120 TAHUN)
+// ==========================================
+
+// Kurup adalah patokan hitungan kalender Jawa. Setiap kurup (120 tahun) satu
+// hari dibuang, sehingga 1 Suro tahun Alip bergeser mundur satu hari dan
+// satu pasaran. Nama kurup diambil dari hari dan pasaran 1 Suro tahun Alip.
+//
+// Kurup yang dipilih dipakai untuk semua tanggal, termasuk di luar masa
+// berlakunya, karena begitulah komunitas penganutnya menghitung.
+type Kurup int
+
+const (
+	// Aboge: Alip Rebo Wage (1747-1866 J), masih dipakai komunitas Aboge.
+	Aboge Kurup = iota
+	// Asapon: Alip Selasa Pon (1867-1986 J), kalender resmi saat ini.
+	Asapon
+	// Anenge: Alip Senen Pahing (1987-2106 J), juga ditulis Anenhing.
+	Anenge
+)
+
+var NamaKurup = []string{"Aboge", "Asapon", "Anenge"}
+
+// DaftarKurup berisi semua kurup yang didukung, berurutan seperti NamaKurup.
+var DaftarKurup = []Kurup{Aboge, Asapon, Anenge}
+
+func (k Kurup) String() string {
+	if k >= 0 && int(k) < len(NamaKurup) {
+		return NamaKurup[k]
+	}
+	return "Unknown"
+}
+
+// tahunPatokan adalah tahun Alip yang 1 Suro-nya dipakai sebagai patokan.
+const tahunPatokan = 1955
+
+// jdnPatokanAsapon adalah JDN 1 Suro 1955 Alip menurut Asapon
+// (Selasa Pon, 10 Agustus 2021).
+const jdnPatokanAsapon = 2459437
+
+const (
+	hariSewindu = 2835 // 5 x 354 + 3 x 355, tepat 405 minggu dan 567 pasaran
+	jumlahBulan = 12
+)
+
+// jdnPatokan mengembalikan JDN 1 Suro 1955 Alip menurut kurup k. Aboge
+// tertinggal satu hari dari Asapon, Anenge mendahului satu hari.
+func (k Kurup) jdnPatokan() int {
+	return jdnPatokanAsapon + int(Asapon-k)
+}
+
+// Wuntu melaporkan apakah tahun Jawa t berumur 355 hari (Ehe, Dal, Jimakir).
+func Wuntu(tahun int) bool {
+	switch (tahun + 5) % 8 {
+	case 1, 4, 7:
+		return true
+	}
+	return false
+}
+
+// PanjangTahun mengembalikan jumlah hari dalam tahun Jawa.
+func PanjangTahun(tahun int) int {
+	if Wuntu(tahun) {
+		return 355
+	}
+	return 354
+}
+
+// PanjangBulan mengembalikan jumlah hari bulan Jawa. Bulan ganjil 30 hari,
+// bulan genap 29 hari, kecuali Besar pada tahun wuntu yang 30 hari.
+func PanjangBulan(tahun, bulan int) int {
+	if bulan%2 == 1 || (bulan == jumlahBulan && Wuntu(tahun)) {
+		return 30
+	}
+	return 29
+}
+
+// floorDiv adalah pembagian bulat ke bawah, juga untuk bilangan negatif.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// JavaneseDateOf menghitung tanggal Jawa untuk tanggal t menurut kurup k
+// dengan hitungan aritmetik windu Sultan Agung.
+func JavaneseDateOf(t time.Time, k Kurup) JavaneseDate {
+	sisa := DateToJDN(t) - k.jdnPatokan()
+	windu := floorDiv(sisa, hariSewindu)
+	sisa -= windu * hariSewindu
+
+	tahun := tahunPatokan + windu*8
+	for sisa >= PanjangTahun(tahun) {
+		sisa -= PanjangTahun(tahun)
+		tahun++
+	}
+	bulan := 1
+	for sisa >= PanjangBulan(tahun, bulan) {
+		sisa -= PanjangBulan(tahun, bulan)
+		bulan++
+	}
+	return JavaneseDate{Tanggal: sisa + 1, Bulan: bulan, Tahun: tahun}
+}
@@ -0,0 +1,26 @@
+package jawa
+
+import "time"
+
+// ==========================================
+// PERGANTIAN HARI SAAT MAGHRIB
+// ==========================================
+
+// DefaultMaghrib adalah perkiraan jam maghrib di Jawa. Karena dekat khatulistiwa,
+// jam maghrib sepanjang tahun hanya bergeser sekitar 17.30-18.15.
+const DefaultMaghrib = 18 * time.Hour
+
+// GeserMaghrib menerapkan aturan hari Jawa yang berganti saat maghrib. Bila jam
+// pada t sudah sama atau lewat dari maghrib, hari Jawa-nya adalah hari
+// berikutnya (misalnya Kamis pukul 19.00 terhitung malam Jumat).
+//
+// Hasilnya adalah tanggal (jam dinolkan) yang dipakai untuk weton, pasaran dan
+// seluruh hitungan, serta apakah terjadi pergeseran.
+func GeserMaghrib(t time.Time, maghrib time.Duration) (time.Time, bool) {
+	tanggal := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	jam := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if jam >= maghrib {
+		return tanggal.AddDate(0, 0, 1), true
+	}
+	return tanggal, false
+}
@@ -0,0 +1,86 @@
+package jawa
+
+import "time"
+
+// ==========================================
+// PRANATA MANGSA (KALENDER SURYA JAWA)
+// ==========================================
+
+var NamaMangsa = []string{"Kasa", "Karo", "Katelu", "Kapat", "Kalima", "Kanem", "Kapitu", "Kawolu", "Kasanga", "Kasadasa", "Dhesta", "Sadha"}
+
+// awalMangsa adalah tanggal Masehi awal tiap mangsa menurut pranata mangsa
+// Pakubuwono VII, dimulai dari Kasa (22 Juni).
+var awalMangsa = []struct {
+	bulan   time.Month
+	tanggal int
+}{
+	{time.June, 22}, {time.August, 2}, {time.August, 25}, {time.September, 18},
+	{time.October, 13}, {time.November, 9}, {time.December, 22}, {time.February, 3},
+	{time.March, 1}, {time.March, 26}, {time.April, 19}, {time.May, 12},
+}
+
+// Mangsa adalah posisi sebuah tanggal di dalam pranata mangsa.
+type Mangsa struct {
+	Ke      int // 1 = Kasa, 12 = Sadha
+	Hari    int // hari ke berapa di dalam mangsa, mulai 1
+	Panjang int // jumlah hari mangsa tersebut (Kawolu 27 hari pada tahun kabisat)
+}
+
+// tanggalAwalMangsa mengembalikan tanggal awal mangsa ke-i (0 = Kasa) untuk
+// siklus yang Kasa-nya dimulai pada tahun tahunKasa.
+func tanggalAwalMangsa(i, tahunKasa int, loc *time.Location) time.Time {
+	a := awalMangsa[i%len(awalMangsa)]
+	tahun := tahunKasa + i/len(awalMangsa)
+	if a.bulan < time.June {
+		tahun++
+	}
+	return time.Date(tahun, a.bulan, a.tanggal, 0, 0, 0, 0, loc)
+}
+
+// MangsaOf menghitung mangsa untuk tanggal t.
+func MangsaOf(t time.Time) Mangsa {
+	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	tahunKasa := t.Year()
+	if t.Before(tanggalAwalMangsa(0, tahunKasa, t.Location())) {
+		tahunKasa--
+	}
+
+	i := len(awalMangsa) - 1
+	for i > 0 && t.Before(tanggalAwalMangsa(i, tahunKasa, t.Location())) {
+		i--
+	}
+	awal := tanggalAwalMangsa(i, tahunKasa, t.Location())
+	akhir := tanggalAwalMangsa(i+1, tahunKasa, t.Location())
+	return Mangsa{
+		Ke:      i + 1,
+		Hari:    DateToJDN(t) - DateToJDN(awal) + 1,
+		Panjang: DateToJDN(akhir) - DateToJDN(awal),
+	}
+}
+
+// InfoMangsa adalah candra (perlambang) dan keterangan tradisional mangsa.
+type InfoMangsa struct {
+	Candra     string
+	Keterangan string
+}
+
+// DataMangsa berurutan sama dengan NamaMangsa.
+var DataMangsa = []InfoMangsa{
+	{"Sotya murca saka ing embanan", "Daun-daun berguguran dan tanah mulai kering. Petani membakar jerami dan mulai menanam palawija."},
+	{"Bantala rengka", "Tanah retak-retak karena kemarau. Pohon randu dan mangga mulai bersemi, palawija mulai tumbuh."},
+	{"Suta manut ing bapa", "Tanaman merambat tumbuh subur. Saatnya panen palawija dan menyiapkan lahan."},
+	{"Waspa kumembeng jroning kalbu", "Sumber air mulai mengering, pohon randu berbuah dan burung mulai bersarang. Petani mulai menggarap sawah untuk padi gogo."},
+	{"Pancuran emas sumawur ing jagad", "Awal musim hujan. Saluran air dibersihkan, pohon asam bersemi dan petani mulai menyebar benih padi."},
+	{"Rasa mulya kasucian", "Musim buah-buahan seperti durian, rambutan dan manggis. Petani menyemai dan membajak sawah."},
+	{"Wisa kentas ing maruta", "Puncak musim hujan, sungai sering meluap dan banyak penyakit. Saatnya menanam padi."},
+	{"Anjrah jroning kayun", "Musim kawin kucing, padi mulai menghijau dan tumbuh tinggi. Hati-hati hama dan angin kencang."},
+	{"Wedaring wacana mulya", "Tonggeret dan gangsir mulai berbunyi. Padi mulai berbunga dan berisi."},
+	{"Gedong minep jroning kalbu", "Burung-burung bertelur dan hewan bunting. Padi mulai menguning."},
+	{"Sotya sinara wedi", "Burung-burung menyuapi anaknya. Musim panen padi."},
+	{"Tirta sah saking sasana", "Awal kemarau, udara dingin dan orang jarang berkeringat. Padi dijemur dan disimpan di lumbung."},
+}
+
+// Info mengembalikan candra dan keterangan mangsa m.
+func (m Mangsa) Info() InfoMangsa {
+	return DataMangsa[m.Ke-1]
+}
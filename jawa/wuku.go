@@ -0,0 +1,88 @@
+package jawa
+
+import "time"
+
+// ==========================================
+// PAWUKON (SIKLUS 30 WUKU / 210 HARI)
+// ==========================================
+
+// Wuku adalah indeks NamaWuku, 0 = Sinta. Setiap wuku berumur 7 hari dan
+// selalu dimulai hari Minggu.
+type Wuku int
+
+var NamaWuku = []string{
+	"Sinta", "Landep", "Wukir", "Kurantil", "Tolu", "Gumbreg",
+	"Warigalit", "Warigagung", "Julungwangi", "Sungsang", "Galungan", "Kuningan",
+	"Langkir", "Mandasiya", "Julungpujut", "Pahang", "Kuruwelut", "Marakeh",
+	"Tambir", "Medangkungan", "Maktal", "Wuye", "Manahil", "Prangbakat",
+	"Bala", "Wugu", "Wayang", "Kulawu", "Dukut", "Watugunung",
+}
+
+const (
+	hariPawukon = 210
+	hariWuku    = 7
+)
+
+// jdnSinta adalah JDN hari Minggu awal wuku Sinta (17 Desember 2023). Wuku
+// Galungan berikutnya memuat Rabu Kliwon 28 Februari 2024.
+const jdnSinta = 2460296
+
+// HariPawukon mengembalikan urutan hari t di dalam siklus pawukon, 1-210.
+func HariPawukon(t time.Time) int {
+	d := (DateToJDN(t) - jdnSinta) % hariPawukon
+	if d < 0 {
+		d += hariPawukon
+	}
+	return d + 1
+}
+
+// WukuOf menghitung wuku untuk tanggal t.
+func WukuOf(t time.Time) Wuku {
+	return Wuku((HariPawukon(t) - 1) / hariWuku)
+}
+
+// InfoWuku adalah sifat-sifat tradisional sebuah wuku menurut primbon.
+type InfoWuku struct {
+	Dewa      string
+	Watak     string
+	Pantangan string
+}
+
+// DataWuku berurutan sama dengan NamaWuku.
+var DataWuku = []InfoWuku{
+	{"Batara Yamadipati", "Berwibawa dan teguh pendirian, namun mudah tersinggung dan keras kepala bila disanggah.", "Kurang baik untuk memulai perjalanan jauh dan memulai pembangunan rumah."},
+	{"Batara Mahadewa", "Cerdas, tajam pikirannya dan pandai bicara, namun cepat marah.", "Hindari memulai perselisihan dan urusan senjata tajam."},
+	{"Batara Mahayekti", "Tenang dan berhati besar seperti gunung, suka melindungi, namun sulit menerima nasihat.", "Kurang baik untuk memindahkan tanaman dan memulai pekerjaan besar."},
+	{"Batara Langsur", "Tekun bekerja dan berumur panjang, namun kadang kurang teliti dalam hitungan.", "Hindari berutang dan meminjamkan uang dalam jumlah besar."},
+	{"Batara Bayu", "Kuat, berani dan banyak akal, suka menolong, namun sering tergesa-gesa.", "Kurang baik untuk berlayar dan bepergian saat angin kencang."},
+	{"Batara Candra", "Lembut, sabar dan menenteramkan, disukai orang banyak, namun mudah bimbang.", "Hindari mengambil keputusan penting secara tergesa-gesa."},
+	{"Batara Asmara", "Halus budi, pandai bergaul dan berbakat seni, namun mudah jatuh hati.", "Kurang baik untuk akad nikah dan pindah rumah."},
+	{"Batara Maharesi", "Bijaksana, suka menuntut ilmu dan menjadi tempat bertanya, namun kaku.", "Hindari memulai usaha dagang yang untung-untungan."},
+	{"Batara Sambu", "Murah hati dan suka berderma, rezekinya lancar, namun boros.", "Kurang baik untuk menebang pohon dan membuka lahan."},
+	{"Batara Gana", "Cerdas, rajin dan cermat, pandai memecahkan masalah, namun pendiam.", "Hindari bertengkar dengan keluarga dan tetangga."},
+	{"Batara Kamajaya", "Rupawan, ramah dan penuh kasih, namun suka dipuji.", "Kurang baik untuk memulai perjalanan ke arah barat."},
+	{"Batara Indra", "Berwibawa, dermawan dan cocok menjadi pemimpin, namun suka berlebihan.", "Hindari memulai usaha baru tanpa perhitungan matang."},
+	{"Batara Kala", "Pemberani dan tahan uji, namun mudah marah dan sulit memaafkan.", "Kurang baik untuk hajatan besar dan mendirikan rumah."},
+	{"Batara Brahma", "Bersemangat dan berkemauan keras seperti api, namun cepat panas hati.", "Hindari pekerjaan yang berhubungan dengan api dan membakar lahan."},
+	{"Batara Guritna", "Teliti, hemat dan pandai menyimpan, namun cenderung kikir.", "Kurang baik untuk meminjamkan barang berharga."},
+	{"Batara Tantra", "Cekatan, pandai berbicara dan pandai mencari nafkah, namun kurang sabar.", "Hindari perjanjian dagang yang belum jelas."},
+	{"Batara Wisnu", "Pengasih, suka mengayomi dan dipercaya orang, namun mudah kecewa.", "Kurang baik untuk mengawali perjalanan ke arah utara."},
+	{"Batara Surenggana", "Rajin, ulet dan banyak rezeki, namun suka menyendiri.", "Hindari memulai pekerjaan di malam hari."},
+	{"Batara Siwah", "Berpendirian kuat dan jujur, namun pemarah dan suka menentang.", "Kurang baik untuk memulai tanam padi dan panen."},
+	{"Batara Basuki", "Sabar, tenang dan pemaaf, hidupnya tenteram, namun lamban.", "Hindari menggali sumur dan membuat pondasi."},
+	{"Batara Sakri", "Kuat, berani dan setia kawan, namun suka memaksakan kehendak.", "Kurang baik untuk berburu dan bepergian jauh."},
+	{"Batara Kuwera", "Pandai mengumpulkan harta dan berhati-hati, namun sulit berbagi.", "Hindari membelanjakan uang untuk barang mewah."},
+	{"Batara Citragotra", "Pandai, banyak keinginan dan suka berinovasi, namun mudah bosan.", "Kurang baik untuk pindah kerja atau pindah rumah."},
+	{"Batara Bisma", "Teguh memegang janji, jujur dan berwibawa, namun keras kepala.", "Hindari bersumpah dan membuat janji yang berat."},
+	{"Batari Durga", "Pemberani, tegas dan kuat, namun mudah berprasangka buruk.", "Kurang baik untuk hajatan pernikahan dan khitanan."},
+	{"Batara Singajalma", "Berani, berwibawa dan dihormati, namun angkuh.", "Hindari bertengkar dan berurusan dengan hukum."},
+	{"Batari Sri", "Tekun, sabar dan membawa rezeki, disayang orang banyak, namun mudah sedih.", "Kurang baik untuk menjual hasil panen dan hewan ternak."},
+	{"Batara Sadana", "Pendiam, hemat dan pandai menyimpan rahasia, namun sulit akrab.", "Hindari memulai perjalanan ke arah timur."},
+	{"Batara Sakri", "Tegas, rajin dan tahan menderita, namun kurang luwes.", "Kurang baik untuk membangun rumah dan memasang atap."},
+	{"Batara Anantaboga", "Berwibawa dan berpengetahuan luas, namun sombong dan sulit mengalah.", "Hindari memulai perkara dan pekerjaan besar menjelang akhir siklus."},
+}
+
+// Info mengembalikan sifat tradisional wuku w.
+func (w Wuku) Info() InfoWuku {
+	return DataWuku[w]
+}
@@ -0,0 +1,193 @@
+// Package konten memuat teks penjelasan fase selamatan dari berkas Markdown
+// dengan front-matter. Salinan bawaan disertakan di dalam aplikasi; paket
+// konten yang lebih baru (berkas zip berisi berkas .md yang sama) bisa
+// dipasang saat aplikasi berjalan dan menggantikan fase yang versinya lebih
+// tinggi.
+package konten
+
+import (
+	"archive/zip"
+	"bytes"
+	"embed"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// ==========================================
+// DEFINISI FASE
+// ==========================================
+
+// Fase adalah penjelasan satu acara selamatan.
+//
+// Berkasnya diawali front-matter di antara dua baris "---":
+//
+//	---
+//	acara: Mitung
+//	judul: Mitung (Hari Ketujuh)
+//	rumus: Tusarro
+//	sumber:
+//	  - Al-Hawi lil Fatawi
+//	versi: 1
+//	---
+//
+// lalu isi Markdown.
+type Fase struct {
+	Acara  string   // nama acara di pakem, kunci pencarian
+	Judul  string   // judul popup penjelasan, default nama acara
+	Rumus  string   // rumus tradisional fase, boleh kosong
+	Sumber []string // kitab atau rujukan
+	Versi  int
+	Isi    string // Markdown tanpa front-matter
+}
+
+// Parse membaca satu berkas fase.
+func Parse(data []byte) (Fase, error) {
+	teks := strings.ReplaceAll(string(data), "\r\n", "\n")
+	if !strings.HasPrefix(teks, "---\n") {
+		return Fase{}, fmt.Errorf("front-matter tidak ditemukan")
+	}
+	kepala, isi, ok := strings.Cut(strings.TrimPrefix(teks, "---\n"), "\n---\n")
+	if !ok {
+		return Fase{}, fmt.Errorf("front-matter tidak ditutup")
+	}
+
+	var f Fase
+	kunci := ""
+	for i, baris := range strings.Split(kepala, "\n") {
+		if strings.TrimSpace(baris) == "" {
+			continue
+		}
+		if item, ok := strings.CutPrefix(strings.TrimSpace(baris), "- "); ok && baris[0] == ' ' {
+			if kunci != "sumber" {
+				return Fase{}, fmt.Errorf("baris %d: daftar hanya untuk sumber", i+2)
+			}
+			f.Sumber = append(f.Sumber, strings.TrimSpace(item))
+			continue
+		}
+		k, v, ok := strings.Cut(baris, ":")
+		if !ok {
+			return Fase{}, fmt.Errorf("baris %d: %q bukan kunci: nilai", i+2, baris)
+		}
+		kunci, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		switch kunci {
+		case "acara":
+			f.Acara = v
+		case "judul":
+			f.Judul = v
+		case "rumus":
+			f.Rumus = v
+		case "sumber":
+			if v != "" {
+				f.Sumber = append(f.Sumber, v)
+			}
+		case "versi":
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return Fase{}, fmt.Errorf("baris %d: versi %q bukan angka", i+2, v)
+			}
+			f.Versi = n
+		}
+		// Kunci lain diabaikan agar paket baru tetap terbaca aplikasi lama.
+	}
+	f.Isi = strings.TrimSpace(isi)
+
+	if f.Acara == "" {
+		return Fase{}, fmt.Errorf("fase tanpa acara")
+	}
+	if f.Judul == "" {
+		f.Judul = f.Acara
+	}
+	if f.Isi == "" {
+		return Fase{}, fmt.Errorf("fase %q kosong", f.Acara)
+	}
+	return f, nil
+}
+
+// ==========================================
+// KONTEN BAWAAN & PAKET
+// ==========================================
+
+//go:embed fase/*.md
+var faseFS embed.FS
+
+// Bawaan mengembalikan fase yang disertakan di dalam aplikasi, dengan kunci
+// nama acara.
+func Bawaan() map[string]Fase {
+	entries, err := faseFS.ReadDir("fase")
+	if err != nil {
+		panic(err)
+	}
+	hasil := map[string]Fase{}
+	for _, e := range entries {
+		data, err := faseFS.ReadFile(path.Join("fase", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		f, err := Parse(data)
+		if err != nil {
+			panic(fmt.Sprintf("konten bawaan %s rusak: %v", e.Name(), err))
+		}
+		hasil[f.Acara] = f
+	}
+	return hasil
+}
+
+// BacaPaket membaca paket konten berbentuk zip. Setiap berkas .md di dalamnya
+// (di folder mana pun) dibaca sebagai fase; berkas lain diabaikan. Hasilnya
+// adalah isi mentah berkas per nama acara, siap disimpan dan dibaca ulang
+// dengan Parse.
+func BacaPaket(data []byte) (map[string]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("paket konten bukan zip: %w", err)
+	}
+	hasil := map[string]string{}
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() || path.Ext(zf.Name) != ".md" {
+			continue
+		}
+		r, err := zf.Open()
+		if err != nil {
+			return nil, err
+		}
+		raw, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			return nil, err
+		}
+		f, err := Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", zf.Name, err)
+		}
+		hasil[f.Acara] = string(raw)
+	}
+	if len(hasil) == 0 {
+		return nil, fmt.Errorf("paket konten tidak berisi berkas .md")
+	}
+	return hasil, nil
+}
+
+// Gabung menimpa fase bawaan dengan fase dari paket yang versinya sama atau
+// lebih tinggi. Fase bawaan yang lebih baru (misalnya setelah aplikasi
+// diperbarui) tetap dipakai, begitu pula fase yang tidak ada di paket. Berkas
+// paket yang rusak dilewati.
+func Gabung(bawaan map[string]Fase, paket map[string]string) map[string]Fase {
+	hasil := make(map[string]Fase, len(bawaan))
+	for k, f := range bawaan {
+		hasil[k] = f
+	}
+	for _, raw := range paket {
+		f, err := Parse([]byte(raw))
+		if err != nil {
+			continue
+		}
+		if lama, ok := hasil[f.Acara]; ok && lama.Versi > f.Versi {
+			continue
+		}
+		hasil[f.Acara] = f
+	}
+	return hasil
+}
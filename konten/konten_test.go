@@ -0,0 +1,126 @@
+package konten
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		nama    string
+		in      string
+		want    Fase
+		wantErr bool
+	}{
+		{
+			nama: "lengkap",
+			in:   "---\nacara: Mitung\njudul: Mitung (Hari Ketujuh)\nrumus: Tusarro\nsumber:\n  - Kitab A\n  - Kitab B\nversi: 2\n---\n\n## Kajian\nIsi.\n",
+			want: Fase{Acara: "Mitung", Judul: "Mitung (Hari Ketujuh)", Rumus: "Tusarro", Sumber: []string{"Kitab A", "Kitab B"}, Versi: 2, Isi: "## Kajian\nIsi."},
+		},
+		{
+			nama: "CRLF, judul bawaan, kunci tak dikenal",
+			in:   "---\r\nacara: Nyewu\r\nbahasa: jv\r\nsumber: Kitab C\r\n---\r\nIsi\r\n",
+			want: Fase{Acara: "Nyewu", Judul: "Nyewu", Sumber: []string{"Kitab C"}, Isi: "Isi"},
+		},
+		{nama: "tanpa front-matter", in: "acara: Mitung\n\nIsi", wantErr: true},
+		{nama: "front-matter tidak ditutup", in: "---\nacara: Mitung\nIsi", wantErr: true},
+		{nama: "versi bukan angka", in: "---\nacara: Mitung\nversi: dua\n---\nIsi", wantErr: true},
+		{nama: "daftar di luar sumber", in: "---\nacara: Mitung\n  - x\n---\nIsi", wantErr: true},
+		{nama: "tanpa acara", in: "---\njudul: X\n---\nIsi", wantErr: true},
+		{nama: "isi kosong", in: "---\nacara: Mitung\n---\n\n", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := Parse([]byte(tt.in))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tt.nama, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s:\n got %+v\nwant %+v", tt.nama, got, tt.want)
+		}
+	}
+}
+
+func TestBawaan(t *testing.T) {
+	b := Bawaan()
+	for _, acara := range []string{"Geblag", "Nelung", "Mitung", "Matang", "Nyatus", "Pendhak I", "Pendhak II", "Nyewu"} {
+		f, ok := b[acara]
+		if !ok {
+			t.Errorf("fase %s tidak ada", acara)
+			continue
+		}
+		if f.Versi < 1 || f.Isi == "" {
+			t.Errorf("fase %s: versi %d, isi %d byte", acara, f.Versi, len(f.Isi))
+		}
+	}
+}
+
+func buatZip(t *testing.T, berkas map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for nama, isi := range berkas {
+		w, err := zw.Create(nama)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(isi))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestPaketGabung(t *testing.T) {
+	bawaan := map[string]Fase{
+		"Mitung": {Acara: "Mitung", Judul: "Mitung lama", Versi: 1, Isi: "lama"},
+		"Nyewu":  {Acara: "Nyewu", Judul: "Nyewu bawaan", Versi: 3, Isi: "bawaan"},
+		"Nelung": {Acara: "Nelung", Judul: "Nelung", Versi: 1, Isi: "tetap"},
+	}
+	data := buatZip(t, map[string]string{
+		"id/03-mitung.md": "---\nacara: Mitung\njudul: Mitung baru\nversi: 2\n---\nbaru",
+		"id/08-nyewu.md":  "---\nacara: Nyewu\njudul: Nyewu paket\nversi: 2\n---\npaket",
+		"README.txt":      "diabaikan",
+	})
+	paket, err := BacaPaket(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paket) != 2 {
+		t.Fatalf("paket berisi %d fase, want 2", len(paket))
+	}
+
+	g := Gabung(bawaan, paket)
+	if g["Mitung"].Judul != "Mitung baru" {
+		t.Errorf("Mitung versi paket lebih baru tidak dipakai: %q", g["Mitung"].Judul)
+	}
+	if g["Nyewu"].Judul != "Nyewu bawaan" {
+		t.Errorf("Nyewu bawaan lebih baru tertimpa paket: %q", g["Nyewu"].Judul)
+	}
+	if g["Nelung"].Isi != "tetap" {
+		t.Errorf("fase yang tidak ada di paket berubah: %+v", g["Nelung"])
+	}
+	if bawaan["Mitung"].Judul != "Mitung lama" {
+		t.Error("Gabung mengubah map bawaan")
+	}
+
+	rusak := Gabung(bawaan, map[string]string{"Mitung": "bukan front-matter"})
+	if rusak["Mitung"].Judul != "Mitung lama" {
+		t.Errorf("berkas paket rusak tidak dilewati: %+v", rusak["Mitung"])
+	}
+}
+
+func TestBacaPaketError(t *testing.T) {
+	if _, err := BacaPaket([]byte("bukan zip")); err == nil {
+		t.Error("data bukan zip tanpa error")
+	}
+	if _, err := BacaPaket(buatZip(t, map[string]string{"a.txt": "x"})); err == nil {
+		t.Error("zip tanpa .md tanpa error")
+	}
+	if _, err := BacaPaket(buatZip(t, map[string]string{"a.md": "tanpa front-matter"})); err == nil {
+		t.Error("berkas .md rusak tanpa error")
+	}
+}
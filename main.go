@@ -20,6 +20,10 @@ import (
 	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/konten"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
 )
 
 // ==========================================
@@ -54,169 +58,160 @@ var bgPngData []byte
 // 2. DATA PENJELASAN FASE KEMATIAN
 // ==========================================
 
-var DeskripsiFase = map[string]string{
-	"Geblag": `Rumus: 
-Jisarji (dino ke siji pasaran ke siji).
-	
-Kajian:
-Pada hari pertama, jasad mulai mengalami perubahan fisik yang nyata. Ruh digambarkan masih sangat dekat dengan jasadnya dan merasa kaget dengan suasana kubur yang gelap dan sempit.
-
-Kondisi Jasad:
-Bagian perut mulai membuncit karena gas mulai terbentuk di dalam usus. Warna kulit yang tadinya cerah berubah menjadi pucat kebiruan atau hijau kehitaman, terutama di area perut dan kemaluan.
-
-Hikmah:
-Inilah alasan mengapa keluarga disunnahkan memberikan sedekah pada malam pertama untuk meringankan beban "kagetnya" ruh di alam baru.`,
-
-	"Nelung": `Rumus:
-Lusarlu (Dino ke telu pasaran ke telu).
-	
-Kajian: 
-Hari ketiga adalah fase di mana rupa manusia mulai hilang secara perlahan.
-
-Kondisi Jasad:
-Cairan mulai keluar dari lubang-lubang tubuh (hidung, mulut, dan telinga). Bau busuk mulai keluar dengan sangat menyengat karena bakteri pembusuk telah menyebar ke seluruh organ dalam.
-
-Kondisi Organ:
-Lidah mulai membengkak dan sering kali terjepit oleh gigi karena ruang di dalam mulut menyempit akibat gas. Mata mulai melunak dan tampak agak menonjol.`,
-
-	"Mitung": `Rumus:
-Tusarro (Dino ke pitu pasaran ke loro).
-
-Kajian:
-Hari ketujuh merupakan fase transisi besar dalam proses penghancuran organ dalam.
-
-Kondisi Jasad:
-Perut yang tadinya membuncit akan pecah karena tekanan gas dan aktivitas bakteri. Organ-organ vital seperti hati, paru-paru, dan lambung mulai mencair dan hancur.
+// Penjelasan fase dimuat dari berkas Markdown di paket konten (bawaan di
+// konten/fase, paket baru bisa dipasang dari Pengaturan). KontenFase berisi
+// hasil gabungannya, dengan kunci nama acara.
+var KontenFase = konten.Bawaan()
 
-Sisi Spiritual:
-Berdasarkan keterangan dalam kitab Al-Hawi lil Fatawi (Imam Suyuthi) yang sering disandingkan dengan Daqa'iqul Akhbar, tujuh hari pertama adalah masa Fitnah Kubur (ujian dan pertanyaan malaikat). Oleh karena itu, sedekah makanan pada hari ke-7 sangat ditekankan.`,
-
-	"Matang": `Rumus:
-Masarma (Dino ke limo pasaran ke limo).
-	
-Kajian:
-Pada hari ke-40, jasad sudah tidak lagi menyerupai sosok manusia yang dikenal semasa hidup.
-
-Kondisi Jasad:
-Seluruh daging mulai terlepas dari tulang belulang. Daging-daging tersebut mulai meluruh dan menyatu dengan tanah.
-
-Kondisi Wajah:
-Kulit wajah sudah hancur sepenuhnya, mata sudah hilang dari kelopaknya, dan rambut mulai rontok dari kulit kepala.
-
-Tradisi:
-Dipercaya pada hari ke-40, proses "pembersihan" sisa daging sedang terjadi secara masif, sehingga doa dikirimkan agar ruh diberikan ketenangan dalam melihat jasadnya yang hancur.`,
-
-	"Nyatus": `Rumus:
-Rosarma (Dino ke loro pasaran ke limo).
-
-Kajian:
-Memasuki hari ke-100, proses pembusukan daging sudah hampir selesai secara total.
-
-Kondisi Jasad:
-Tubuh kini didominasi oleh rangka. Hanya menyisakan sedikit jaringan otot atau kulit yang mengeras (seperti mumi) di area-area tertentu yang sulit hancur.
+// ==========================================
+// 3. FORMAT TANGGAL & WETON
+// ==========================================
 
-Bau:
-Bau busuk yang menyengat sudah mulai berkurang karena sumber pembusukan (daging dan organ dalam) sudah menyatu dengan tanah.`,
+var BulanIndo = []string{"", "Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
 
-	"Pendhak I": `Rumus:
-Patsarpat (Dino ke papat pasaran ke papat).
+// Perhitungan kalender ada di paket jawa. Fungsi-fungsi di bawah ini hanya
+// lapisan format teks untuk UI.
 
-Kajian: 
-Istilah "Pendhak" adalah tradisi lokal Nusantara untuk menyebut Haul atau peringatan tahunan.
+func formatWeton(t time.Time, k jawa.Kurup) string {
+	return fmt.Sprintf("%s, %s", jawa.WetonOf(t), jawa.JavaneseDateOf(t, k))
+}
 
-Kondisi Jasad:
-Tulang-belulang mulai menjadi kering. Sumsum di dalam tulang sudah habis. Sendi-sendi yang menghubungkan tulang satu dengan yang lain mulai terlepas.
+func formatTahunJawa(t time.Time, k jawa.Kurup) string {
+	d := jawa.JavaneseDateOf(t, k)
+	return fmt.Sprintf("Tahun %s %d, Windu %s", d.NamaTahun(), d.Tahun, d.NamaWindu())
+}
 
-Kondisi Tengkorak:
-Rahang bawah biasanya sudah terlepas dari tengkorak. Tubuh benar-benar sudah menjadi serpihan tulang yang terpisah-pisah.`,
+func formatWuku(t time.Time) string {
+	return "Wuku " + jawa.WukuOf(t).String()
+}
 
-	"Pendhak II": `Rumus:
-Rosarpat (Dino ke siji pasaran ke telu).
+func formatInfoWuku(w jawa.Wuku) string {
+	info := w.Info()
+	return fmt.Sprintf("Dewa: %s\n\nWatak: %s\n\nPantangan: %s", info.Dewa, info.Watak, info.Pantangan)
+}
 
-Kajian:
-Memasuki tahun kedua, proses dekomposisi tulang berlanjut.
+func formatMangsa(t time.Time) string {
+	return "Mangsa " + jawa.MangsaOf(t).String()
+}
 
-Kondisi Jasad:
-Tulang-belulang semakin kering dan mulai terurai oleh tanah. Sendi-sendi utama sudah lepas sepenuhnya. Struktur kerangka tubuh sudah tidak utuh lagi.
+func formatInfoMangsa(m jawa.Mangsa) string {
+	info := m.Info()
+	return fmt.Sprintf("\"%s\"\n\n%s", info.Candra, info.Keterangan)
+}
 
-Makna:
-Peringatan ini menjadi penanda bahwa hubungan fisik almarhum dengan dunia semakin pudar, dan yang tersisa hanyalah doa dari anak cucu serta amal jariyahnya.`,
+func formatPetungan(p jawa.Petungan) string {
+	tanda := "✗"
+	if p.Baik {
+		tanda = "✓"
+	}
+	sisa := make([]string, len(p.Sisa))
+	for i, n := range p.Sisa {
+		sisa[i] = fmt.Sprintf("%d", n)
+	}
+	return fmt.Sprintf("%s %s: %s (sisa %s)", tanda, p.Metode, p.Nama, strings.Join(sisa, " & "))
+}
 
-	"Nyewu": `Rumus:
-Nemsarma (Dino ke enem pasaran ke limo).
-	
-Kajian:
-Ini adalah fase terakhir dalam proses dekomposisi jasad manusia secara alami.
+func formatKurup(k jawa.Kurup) string {
+	return "Penanggalan Jawa: kurup " + k.String()
+}
 
-Kondisi Jasad:
-Tulang-belulang mulai melapuk dan menjadi rapuh. Dalam kitab dijelaskan bahwa pada fase ini, jasad sudah benar-benar menyatu dengan tanah (menjadi debu).
+func formatIndoDate(t time.Time) string {
+	return fmt.Sprintf("%d %s %d", t.Day(), BulanIndo[t.Month()], t.Year())
+}
 
-Satu Bagian yang Tersisa:
-Dalam keyakinan Islam (berdasarkan Hadis Nabi), hanya satu bagian yang tidak akan hancur dimakan tanah, yaitu Ajbuz Dzamb (tulang ekor yang sangat kecil), yang darinya manusia akan dibangkitkan kembali pada hari kiamat.
+// formatIndoDateTime menambahkan jam bila t bukan tepat tengah malam.
+func formatIndoDateTime(t time.Time) string {
+	if jam := jamOf(t); jam != 0 {
+		return formatIndoDate(t) + ", " + formatJam(jam)
+	}
+	return formatIndoDate(t)
+}
 
-Makna Doa:
-Peringatan 1000 hari dimaksudkan sebagai doa pamungkas bagi keluarga untuk memohonkan ampunan total bagi almarhum/ah karena perjalanan jasadnya di bumi sudah selesai secara fisik.`,
+func jamOf(t time.Time) time.Duration {
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
 }
 
-// ==========================================
-// 3. LOGIKA MATEMATIKA & KALENDER JAWA
-// ==========================================
+func formatJam(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+}
 
-var (
-	HariIndo     = []string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
-	Pasaran      = []string{"Legi", "Pahing", "Pon", "Wage", "Kliwon"}
-	BulanIndo    = []string{"", "Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
-	BulanJawa    = []string{"", "Suro", "Sapar", "Mulud", "Bakda Mulud", "Jumadil Awal", "Jumadil Akhir", "Rajeb", "Ruwah", "Poso", "Sawal", "Sela", "Besar"}
-	NilaiHari    = []int{5, 4, 3, 7, 8, 6, 9}
-	NilaiPasaran = []int{5, 9, 7, 4, 8}
-)
+// parseJam membaca jam "HH:MM" atau "HH.MM". Teks kosong berarti tanpa jam.
+func parseJam(s string) (time.Duration, error) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, ".", ":"))
+	if s == "" {
+		return 0, nil
+	}
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil {
+		return 0, err
+	}
+	if h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("jam di luar rentang: %s", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
 
-func dateToJDN(t time.Time) int {
-	a := (14 - int(t.Month())) / 12
-	y := t.Year() + 4800 - a
-	m := int(t.Month()) + 12*a - 3
-	return t.Day() + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+// formatGeserMaghrib menjelaskan mengapa hari Jawa bergeser ke hari berikutnya.
+func formatGeserMaghrib(asli, hariJawa time.Time, maghrib time.Duration, k jawa.Kurup) string {
+	return fmt.Sprintf("Jam %s pada %s %s sudah lewat maghrib (%s). Dalam hitungan Jawa hari berganti saat maghrib, "+
+		"sehingga peristiwa ini terhitung malam %s, yaitu %s.\n\nWeton, pasaran dan seluruh jadwal dihitung dari hari tersebut.",
+		formatJam(jamOf(asli)), jawa.NamaHari[asli.Weekday()], formatIndoDate(asli), formatJam(maghrib),
+		jawa.WetonOf(hariJawa), formatWeton(hariJawa, k))
 }
 
-func getJavaneseDate(t time.Time) string {
-	jd := dateToJDN(t)
-	l := jd - 1948440 + 10632 + 1
-	n := (l - 1) / 10631
-	l = l - 10631*n + 354
-	j := (int)((10985 - l) / 5316) * (int)((50 * l) / 17719) + (int)(l / 5670) * (int)((43 * l) / 15238)
-	l = l - (int)((30 - j) / 15) * (int)((17719 * j) / 50) - (int)(j / 16) * (int)((15238 * j) / 43) + 29
-	hm := (int)(24 * l) / 709
-	hd := l - (int)(709 * hm) / 24
-	namaBulanJawa := ""
-	if hm > 0 && hm < len(BulanJawa) {
-		namaBulanJawa = BulanJawa[hm]
-	} else {
-		namaBulanJawa = "Unknown"
+// rentangRumus mengembalikan rumus acara pertama dan terakhir pakem yang
+// dihitung dari selisih hari, misalnya Lusarlu hingga Nemsarma pada pakem
+// standar. ok false bila pakem tidak punya acara berselisih hari.
+func rentangRumus(p selamatan.Pakem) (awal, akhir selamatan.Rumus, ok bool) {
+	min, max := 0, 0
+	for _, a := range p.Acara {
+		if a.Hari <= 0 {
+			continue
+		}
+		if !ok || a.Hari < min {
+			min = a.Hari
+		}
+		if !ok || a.Hari > max {
+			max = a.Hari
+		}
+		ok = true
 	}
-	return fmt.Sprintf("%d %s", hd, namaBulanJawa)
+	return selamatan.RumusOf(min), selamatan.RumusOf(max), ok
 }
 
-func formatWeton(t time.Time) string {
-	hari := HariIndo[t.Weekday()]
-	jd := dateToJDN(t)
-	pasaranIdx := jd % 5
-	pasaran := Pasaran[pasaranIdx]
-	jawaDate := getJavaneseDate(t)
-	return fmt.Sprintf("%s %s, %s", hari, pasaran, jawaDate)
+// formatRumus menulis bagian Markdown rumus untuk penjelasan fase.
+func formatRumus(r selamatan.Rumus) string {
+	return fmt.Sprintf("## Rumus\n**%s** (%s).", r, r.Keterangan())
 }
 
-func formatIndoDate(t time.Time) string {
-	return fmt.Sprintf("%d %s %d", t.Day(), BulanIndo[t.Month()], t.Year())
+// formatTanggalLain menjelaskan tanggal kedua acara yang punya selisih hari
+// dan ulang tahun Jawa sekaligus (pendhak). Kosong bila hanya satu tanggal.
+func formatTanggalLain(j selamatan.Jadwal) string {
+	if !j.PunyaDuaTanggal() {
+		return ""
+	}
+	if j.TanggalHari.Equal(j.TanggalJawa) {
+		return fmt.Sprintf("Hitungan hari ke-%d dan tanggal Jawa jatuh di hari yang sama", j.Acara.Hari+1)
+	}
+	if j.Tanggal.Equal(j.TanggalJawa) {
+		return fmt.Sprintf("Menurut hitungan hari ke-%d: %s", j.Acara.Hari+1, formatIndoDate(j.TanggalHari))
+	}
+	return "Menurut tanggal Jawa: " + formatIndoDate(j.TanggalJawa)
 }
 
-func calculateNeptu(t time.Time) string {
-	idxHari := int(t.Weekday())
-	valHari := NilaiHari[idxHari]
-	jd := dateToJDN(t)
-	idxPasaran := jd % 5
-	valPasaran := NilaiPasaran[idxPasaran]
-	total := valHari + valPasaran
-	return fmt.Sprintf("Jumlah Neptu: %d", total)
+// formatStatus menampilkan jarak hari acara dari hari ini.
+func formatStatus(diffDays int) string {
+	switch {
+	case diffDays < 0:
+		return fmt.Sprintf("✓ Sudah Lewat (%d hari)", int(math.Abs(float64(diffDays))))
+	case diffDays == 0:
+		return "🔔 HARI INI!"
+	}
+	return fmt.Sprintf("⏳ %d Hari Lagi", diffDays)
+}
+
+func formatNeptu(w jawa.Weton) string {
+	return fmt.Sprintf("Jumlah Neptu: %d", w.Neptu())
 }
 
 // ==========================================
@@ -286,11 +281,26 @@ func (c *clickableCard) Tapped(_ *fyne.PointEvent) {
 // 5. LOGIKA KALENDER CUSTOM
 // ==========================================
 
-func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDateChanged func(time.Time), onCalculate func(time.Time)) {
+// Mode input tanggal pada popup kalender.
+const (
+	inputMasehi = iota
+	inputJawa
+	inputHijriah
+)
+
+var NamaModeInput = []string{"Masehi", "Jawa", "Hijriah"}
+
+// Jam pada initialDate (bila bukan 00.00) dipakai sebagai isian awal kolom jam.
+// Selain memilih tanggal Masehi, pengguna bisa mengetik tanggal Jawa (menurut
+// kurup k) atau Hijriah yang lalu dikonversi ke Masehi.
+// onCalculate menerima tanggal terpilih beserta jam yang diisi, atau 00.00 bila
+// kolom jam dikosongkan.
+func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, k jawa.Kurup, onDateChanged func(time.Time), onCalculate func(time.Time)) {
 	currentMonth := initialDate
 	selectedDate := initialDate
 	currentViewMode := 0
 	hasSelected := false
+	inputMode := inputMasehi
 
 	contentStack := container.NewStack()
 	var popup *widget.PopUp
@@ -304,7 +314,9 @@ func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDate
 	toastWrapper := container.NewCenter(toastCard)
 	toastWrapper.Hide()
 
-	showToast := func() {
+	showToast := func(msg string) {
+		toastText.Text = msg
+		toastText.Refresh()
 		toastWrapper.Show()
 		go func() {
 			time.Sleep(2 * time.Second)
@@ -312,10 +324,90 @@ func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDate
 		}()
 	}
 
+	// --- FORM KONVERSI JAWA / HIJRIAH ---
+	entryTgl := widget.NewEntry()
+	entryTgl.SetPlaceHolder("Tgl")
+	selBulan := widget.NewSelect(nil, nil)
+	entryTahun := widget.NewEntry()
+	entryTahun.SetPlaceHolder("Tahun")
+	lblPreview := widget.NewLabel("")
+	lblPreview.Alignment = fyne.TextAlignCenter
+	lblPreview.Wrapping = fyne.TextWrapWord
+
+	convertInput := func() (time.Time, error) {
+		var tgl, thn int
+		if _, err := fmt.Sscanf(strings.TrimSpace(entryTgl.Text), "%d", &tgl); err != nil {
+			return time.Time{}, err
+		}
+		if _, err := fmt.Sscanf(strings.TrimSpace(entryTahun.Text), "%d", &thn); err != nil {
+			return time.Time{}, err
+		}
+		bln := selBulan.SelectedIndex() + 1
+		if inputMode == inputJawa {
+			return jawa.JavaneseToDate(jawa.JavaneseDate{Tanggal: tgl, Bulan: bln, Tahun: thn}, k, initialDate.Location())
+		}
+		return jawa.HijriToDate(jawa.HijriDate{Tanggal: tgl, Bulan: bln, Tahun: thn}, initialDate.Location())
+	}
+
+	updatePreview := func() {
+		t, err := convertInput()
+		if err != nil {
+			lblPreview.SetText("Tanggal tidak valid")
+			return
+		}
+		lblPreview.SetText(fmt.Sprintf("= %s\n%s", formatIndoDate(t), formatWeton(t, k)))
+		if onDateChanged != nil {
+			onDateChanged(t)
+		}
+	}
+	entryTgl.OnChanged = func(string) { updatePreview() }
+	entryTahun.OnChanged = func(string) { updatePreview() }
+
+	// fillForm mengisi form dengan tanggal base dalam kalender mode aktif.
+	fillForm := func(base time.Time) {
+		selBulan.OnChanged = nil
+		if inputMode == inputJawa {
+			d := jawa.JavaneseDateOf(base, k)
+			selBulan.Options = jawa.NamaBulan[1:]
+			selBulan.SetSelectedIndex(d.Bulan - 1)
+			entryTgl.SetText(fmt.Sprintf("%d", d.Tanggal))
+			entryTahun.SetText(fmt.Sprintf("%d", d.Tahun))
+		} else {
+			d := jawa.HijriDateOf(base)
+			selBulan.Options = jawa.NamaBulanHijriah[1:]
+			selBulan.SetSelectedIndex(d.Bulan - 1)
+			entryTgl.SetText(fmt.Sprintf("%d", d.Tanggal))
+			entryTahun.SetText(fmt.Sprintf("%d", d.Tahun))
+		}
+		selBulan.OnChanged = func(string) { updatePreview() }
+		updatePreview()
+	}
+
+	lblFormTitle := canvas.NewText("", ColorTextGrey)
+	lblFormTitle.TextSize = 12
+	convertForm := container.NewVBox(
+		lblFormTitle,
+		container.NewGridWithColumns(2, entryTgl, entryTahun),
+		selBulan,
+		lblPreview,
+	)
+
 	var refreshContent func()
 	refreshContent = func() {
 		year, month, _ := currentMonth.Date()
 
+		if inputMode != inputMasehi {
+			if inputMode == inputJawa {
+				lblFormTitle.Text = "Tanggal Jawa (kurup " + k.String() + "):"
+			} else {
+				lblFormTitle.Text = "Tanggal Hijriah:"
+			}
+			lblFormTitle.Refresh()
+			contentStack.Objects = []fyne.CanvasObject{convertForm}
+			contentStack.Refresh()
+			return
+		}
+
 		if currentViewMode == 0 {
 			titleText := fmt.Sprintf("%s %d", BulanIndo[month], year)
 			btnHeader := widget.NewButton(titleText, func() {
@@ -494,29 +586,65 @@ func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDate
 		contentStack.Refresh()
 	}
 
+	entryJam := widget.NewEntry()
+	entryJam.SetPlaceHolder("Jam (opsional) 19:00")
+	if jam := jamOf(initialDate); jam != 0 {
+		entryJam.SetText(formatJam(jam))
+	}
+
+	selMode := widget.NewSelect(NamaModeInput, nil)
+	selMode.SetSelectedIndex(inputMasehi)
+	selMode.OnChanged = func(string) {
+		inputMode = selMode.SelectedIndex()
+		if inputMode != inputMasehi {
+			base := initialDate
+			if hasSelected {
+				base = selectedDate
+			}
+			fillForm(base)
+		}
+		refreshContent()
+	}
+	lblMode := canvas.NewText("Input:", ColorTextGrey)
+	lblMode.TextSize = 12
+	modeRow := container.NewBorder(nil, nil, lblMode, nil, selMode)
+
 	btnHitung := widget.NewButton("Hitung", func() {
-		if currentViewMode != 0 {
-			showToast()
+		if inputMode != inputMasehi {
+			t, err := convertInput()
+			if err != nil {
+				showToast("Tanggal tidak valid!")
+				return
+			}
+			selectedDate = t
+			hasSelected = true
+		} else if currentViewMode != 0 {
+			showToast("Pilih tanggal dulu!")
 			return
 		}
 
 		if !hasSelected {
-			showToast()
+			showToast("Pilih tanggal dulu!")
+			return
+		}
+		jam, err := parseJam(entryJam.Text)
+		if err != nil {
+			showToast("Format jam salah!")
 			return
 		}
 		if popup != nil {
 			popup.Hide()
 		}
-		onCalculate(selectedDate)
+		onCalculate(time.Date(selectedDate.Year(), selectedDate.Month(), selectedDate.Day(), 0, 0, 0, 0, selectedDate.Location()).Add(jam))
 	})
 	btnHitung.Importance = widget.HighImportance
 	btnHitung.Icon = theme.ConfirmIcon()
-	bottomArea := container.NewCenter(btnHitung)
+	bottomArea := container.NewBorder(nil, nil, nil, btnHitung, entryJam)
 
 	refreshContent()
 
 	finalLayout := container.NewBorder(
-		nil,
+		modeRow,
 		container.NewPadded(bottomArea),
 		nil, nil,
 		contentStack,
@@ -524,7 +652,7 @@ func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDate
 
 	bgRect := canvas.NewRectangle(ColorCardBg)
 	bgRect.CornerRadius = 12
-	bgRect.SetMinSize(fyne.NewSize(280, 330))
+	bgRect.SetMinSize(fyne.NewSize(280, 410))
 
 	cardContent := container.NewStack(
 		bgRect,
@@ -534,7 +662,7 @@ func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDate
 	centeredPopup := container.NewCenter(cardContent)
 
 	popup = widget.NewModalPopUp(centeredPopup, parentCanvas)
-	popup.Resize(fyne.NewSize(280, 330))
+	popup.Resize(fyne.NewSize(280, 410))
 	popup.Show()
 }
 
@@ -542,21 +670,69 @@ func createCalendarPopup(parentCanvas fyne.Canvas, initialDate time.Time, onDate
 // 6. HELPER UI CARDS
 // ==========================================
 
-func createCard(title, subTitle, dateStr, wetonStr, rumusStr, descStr string, statusType int, diffDays int, parentCanvas fyne.Canvas) fyne.CanvasObject {
+// createJadwalCard membuat kartu satu acara selamatan. now adalah hari ini
+// (jam dinolkan) untuk menentukan status.
+func createJadwalCard(e selamatan.Jadwal, k jawa.Kurup, now time.Time, statusExtra fyne.CanvasObject, parentCanvas fyne.Canvas) fyne.CanvasObject {
+	targetDate := e.Tanggal
+	diff := int(targetDate.Sub(now).Hours() / 24)
+	status := statusOf(diff)
+	desc := e.Acara.Deskripsi
+	fase, ada := KontenFase[e.Acara.Nama]
+	if desc == "" && ada {
+		desc = formatFase(fase)
+	}
+	rumus := formatRumus(e.Rumus)
+	if ada && fase.Rumus != "" && !strings.EqualFold(fase.Rumus, e.Rumus.String()) {
+		rumus += fmt.Sprintf("\n\n*Rumus tradisional %s adalah %s; tanggal ini memakai hitungan yang berbeda.*", e.Acara.Nama, fase.Rumus)
+	}
+	desc = rumus + "\n\n" + desc
+	judul := e.Acara.Nama
+	if ada {
+		judul = fase.Judul
+	}
+	info := []string{formatTahunJawa(targetDate, k), formatWuku(targetDate)}
+	if lain := formatTanggalLain(e); lain != "" {
+		info = append(info, lain)
+	}
+	return createCard(e.Acara.Nama, e.Acara.Sub, formatIndoDate(targetDate), formatWeton(targetDate, k), e.Rumus.String(), desc, judul, status, diff, statusExtra, parentCanvas, info...)
+}
+
+// statusOf mengubah selisih hari menjadi statusType createCard: 1 sudah
+// lewat, 2 hari ini, 3 akan datang.
+func statusOf(diffDays int) int {
+	switch {
+	case diffDays < 0:
+		return 1
+	case diffDays == 0:
+		return 2
+	}
+	return 3
+}
+
+// createBadge membuat badge status (statusType 1..3) seperti di kartu jadwal.
+func createBadge(statusType, diffDays int) fyne.CanvasObject {
 	var badgeColor color.Color
-	var badgeTextStr string
 	switch statusType {
 	case 1:
 		badgeColor = ColorBadgeGreen
-		badgeTextStr = fmt.Sprintf("✓ Sudah Lewat (%d hari)", int(math.Abs(float64(diffDays))))
 	case 2:
 		badgeColor = ColorBadgeRed
-		badgeTextStr = "🔔 HARI INI!"
 	case 3:
 		badgeColor = ColorBadgeBlue
-		badgeTextStr = fmt.Sprintf("⏳ %d Hari Lagi", diffDays)
 	}
+	lblBadge := canvas.NewText(formatStatus(diffDays), ColorTextWhite)
+	lblBadge.TextSize = 11
+	lblBadge.TextStyle = fyne.TextStyle{Bold: true}
+	badgeBg := canvas.NewRectangle(badgeColor)
+	badgeBg.CornerRadius = 12
+	return container.NewStack(badgeBg, container.NewPadded(lblBadge))
+}
 
+// descTitle adalah judul popup penjelasan, kosong berarti memakai title.
+// statusExtra, bila ada, ditaruh di samping badge status. infoLines adalah
+// baris keterangan tambahan (tahun Jawa, wuku, dll) yang ditampilkan kecil di
+// bawah weton.
+func createCard(title, subTitle, dateStr, wetonStr, rumusStr, descStr, descTitle string, statusType int, diffDays int, statusExtra fyne.CanvasObject, parentCanvas fyne.Canvas, infoLines ...string) fyne.CanvasObject {
 	lblTitle := canvas.NewText(title, ColorTextWhite)
 	lblTitle.TextSize = 16
 	lblTitle.TextStyle = fyne.TextStyle{Bold: true}
@@ -573,28 +749,30 @@ func createCard(title, subTitle, dateStr, wetonStr, rumusStr, descStr string, st
 	lblWeton.Alignment = fyne.TextAlignTrailing
 	lblWeton.TextSize = 11
 
-	var rightCont *fyne.Container
+	rightCont := container.NewVBox(lblDate, lblWeton)
+	for _, info := range infoLines {
+		lblInfo := canvas.NewText(info, ColorTextGrey)
+		lblInfo.Alignment = fyne.TextAlignTrailing
+		lblInfo.TextSize = 10
+		rightCont.Add(lblInfo)
+	}
 	if rumusStr != "" {
 		lblRumus := canvas.NewText(rumusStr, ColorTextOrange)
 		lblRumus.Alignment = fyne.TextAlignTrailing
 		lblRumus.TextSize = 10
 		lblRumus.TextStyle = fyne.TextStyle{Italic: true}
-		rightCont = container.NewVBox(lblDate, lblWeton, lblRumus)
-	} else {
-		rightCont = container.NewVBox(lblDate, lblWeton)
+		rightCont.Add(lblRumus)
 	}
 
 	topRow := container.NewBorder(nil, nil, leftCont, rightCont)
 
 	var botRow fyne.CanvasObject
 	if statusType >= 1 && statusType <= 3 {
-		lblBadge := canvas.NewText(badgeTextStr, ColorTextWhite)
-		lblBadge.TextSize = 11
-		lblBadge.TextStyle = fyne.TextStyle{Bold: true}
-		badgeBg := canvas.NewRectangle(badgeColor)
-		badgeBg.CornerRadius = 12
-		badgeCont := container.NewStack(badgeBg, container.NewPadded(lblBadge))
-		botRow = container.NewHBox(badgeCont)
+		row := container.NewHBox(createBadge(statusType, diffDays))
+		if statusExtra != nil {
+			row.Add(statusExtra)
+		}
+		botRow = row
 	} else {
 		botRow = layout.NewSpacer()
 	}
@@ -605,14 +783,19 @@ func createCard(title, subTitle, dateStr, wetonStr, rumusStr, descStr string, st
 
 	visualCard := container.NewStack(bg, container.NewPadded(content))
 
+	if descTitle == "" {
+		descTitle = title
+	}
 	if descStr != "" && parentCanvas != nil {
 		return newClickableCard(visualCard, func() {
-			lblDesc := widget.NewLabel(descStr)
-			lblDesc.Wrapping = fyne.TextWrapWord
-
-			lblHeader := widget.NewLabel("Penjelasan Fase: " + title)
+			header := "Penjelasan Fase: " + descTitle
+			if statusType == 4 {
+				header = "Penjelasan: " + descTitle
+			}
+			lblHeader := widget.NewLabel(header)
 			lblHeader.Alignment = fyne.TextAlignCenter
 			lblHeader.TextStyle = fyne.TextStyle{Bold: true}
+			lblHeader.Wrapping = fyne.TextWrapWord
 
 			var popup *widget.PopUp
 			btnClose := widget.NewButton("Tutup", func() {
@@ -622,14 +805,19 @@ func createCard(title, subTitle, dateStr, wetonStr, rumusStr, descStr string, st
 			})
 			btnClose.Importance = widget.HighImportance
 
-			scrollContainer := container.NewVScroll(container.NewPadded(lblDesc))
-			scrollContainer.SetMinSize(fyne.NewSize(0, 300))
+			var buttonRow fyne.CanvasObject = btnClose
+			if statusType >= 1 && statusType <= 3 {
+				btnBacaan := widget.NewButtonWithIcon("Bacaan Tahlil", theme.DocumentIcon(), func() {
+					showBacaanPopup(parentCanvas, fyne.CurrentApp().Preferences())
+				})
+				buttonRow = container.NewGridWithColumns(2, btnClose, btnBacaan)
+			}
 
 			contentBox := container.NewBorder(
 				lblHeader,
-				container.NewPadded(btnClose),
+				container.NewPadded(buttonRow),
 				nil, nil,
-				scrollContainer,
+				createDeskripsiView(descStr),
 			)
 
 			bgRect := canvas.NewRectangle(ColorCardBg)
@@ -646,6 +834,63 @@ func createCard(title, subTitle, dateStr, wetonStr, rumusStr, descStr string, st
 	return visualCard
 }
 
+// createInfoCard membuat kartu berisi judul dan teks keterangan panjang.
+func createInfoCard(title, text string) fyne.CanvasObject {
+	lblTitle := canvas.NewText(title, ColorTextWhite)
+	lblTitle.TextSize = 12
+	lblTitle.TextStyle = fyne.TextStyle{Bold: true}
+	lblText := widget.NewLabel(text)
+	lblText.Wrapping = fyne.TextWrapWord
+	bg := canvas.NewRectangle(ColorCardBg)
+	bg.CornerRadius = 10
+	return container.NewStack(bg, container.NewPadded(container.NewVBox(lblTitle, lblText)))
+}
+
+// createKurupTable menyusun tabel tanggal Jawa tiap kurup secara berdampingan.
+// Baris pertama adalah nama kurup, kolom pertama adalah label tiap tanggal.
+func createKurupTable(labels []string, dates []time.Time) fyne.CanvasObject {
+	grid := container.New(layout.NewGridLayout(len(jawa.DaftarKurup) + 1))
+
+	addCell := func(text string, c color.Color, bold bool) {
+		lbl := canvas.NewText(text, c)
+		lbl.TextSize = 11
+		lbl.TextStyle = fyne.TextStyle{Bold: bold}
+		grid.Add(lbl)
+	}
+
+	addCell("", ColorTextGrey, true)
+	for _, k := range jawa.DaftarKurup {
+		addCell(k.String(), ColorTextOrange, true)
+	}
+	for i, t := range dates {
+		addCell(labels[i], ColorTextWhite, true)
+		for _, k := range jawa.DaftarKurup {
+			d := jawa.JavaneseDateOf(t, k)
+			addCell(fmt.Sprintf("%d %s", d.Tanggal, d.NamaBulan()), ColorTextGrey, false)
+		}
+	}
+	return grid
+}
+
+// createGeserNote membuat catatan kecil bahwa hari Jawa bergeser karena lewat
+// maghrib. Bila diketuk, penjelasan lengkap ditampilkan.
+func createGeserNote(asli, hariJawa time.Time, settings appSettings, parentCanvas fyne.Canvas) fyne.CanvasObject {
+	lblNote := canvas.NewText(fmt.Sprintf("⚠ Lewat maghrib, dihitung %s (ketuk)", jawa.WetonOf(hariJawa)), ColorTextOrange)
+	lblNote.TextSize = 11
+	lblNote.TextStyle = fyne.TextStyle{Bold: true}
+	bg := canvas.NewRectangle(ColorCardBg)
+	bg.CornerRadius = 8
+	return newClickableCard(container.NewStack(bg, container.NewPadded(lblNote)), func() {
+		showGeserDialog(asli, hariJawa, settings, parentCanvas)
+	})
+}
+
+func showGeserDialog(asli, hariJawa time.Time, settings appSettings, parentCanvas fyne.Canvas) {
+	lblDesc := widget.NewLabel(formatGeserMaghrib(asli, hariJawa, settings.JamMaghrib, settings.Kurup))
+	lblDesc.Wrapping = fyne.TextWrapWord
+	showModalCard(parentCanvas, "Hari Bergeser Saat Maghrib", lblDesc)
+}
+
 // ==========================================
 // 7. MAIN APP
 // ==========================================
@@ -789,6 +1034,9 @@ func main() {
 	myApp := app.New()
 	myApp.Settings().SetTheme(&myTheme{Theme: theme.DefaultTheme()})
 
+	settings := loadSettings(myApp.Preferences())
+	muatKonten(myApp.Preferences())
+
 	myWindow := myApp.NewWindow("Kalkulator Selamatan Jawa & Weton")
 	myWindow.Resize(fyne.NewSize(400, 750))
 
@@ -802,11 +1050,19 @@ func main() {
 	headerTitle.TextSize = 18
 	headerIcon := canvas.NewImageFromResource(theme.InfoIcon())
 	headerIcon.SetMinSize(fyne.NewSize(30, 30))
+	// Diisi setelah kedua tab selesai dibuat.
+	var onSettingsChanged func()
+	btnSettings := widget.NewButtonWithIcon("", theme.SettingsIcon(), func() {
+		showSettingsPopup(myWindow, myApp.Preferences(), &settings, onSettingsChanged)
+	})
+	btnSettings.Importance = widget.LowImportance
 	headerStack := container.NewStack(
 		gradient,
 		container.NewPadded(container.NewVBox(
 			layout.NewSpacer(),
-			container.NewHBox(layout.NewSpacer(), headerIcon, headerTitle, layout.NewSpacer()),
+			container.NewBorder(nil, nil, nil, btnSettings,
+				container.NewHBox(layout.NewSpacer(), headerIcon, headerTitle, layout.NewSpacer()),
+			),
 			layout.NewSpacer(),
 		)),
 	)
@@ -819,7 +1075,10 @@ func main() {
 	resultBox := container.NewVBox()
 	scrollArea := container.NewVScroll(container.NewPadded(resultBox))
 
-	calcDate := time.Now()
+	today := time.Now()
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+
+	calcDate := today
 	lblDateTitle := canvas.NewText("Tanggal Wafat / Geblag:", ColorTextGrey)
 	lblDateTitle.TextSize = 12
 
@@ -827,9 +1086,17 @@ func main() {
 	lblSelectedDate.Alignment = fyne.TextAlignCenter
 	lblSelectedDate.TextStyle = fyne.TextStyle{Bold: true}
 
+	// Profil yang sedang dibuka, nil bila tanggal dipilih langsung.
+	var profilAktif *profil
+	// Penjelasan bila geblag didapat dari hitung mundur.
+	var catatanMundur string
+	lblProfil := widget.NewLabel("")
+	lblProfil.Alignment = fyne.TextAlignCenter
+	lblProfil.Hide()
+
 	// Helper update text
 	updateDateLabel := func(t time.Time) {
-		lblSelectedDate.SetText(formatIndoDate(t))
+		lblSelectedDate.SetText(formatIndoDateTime(t))
 	}
 	updateDateLabel(calcDate)
 
@@ -837,42 +1104,97 @@ func main() {
 		updateDateLabel(t)
 		resultBox.Objects = nil
 
-		type Event struct {
-			Name   string
-			Sub    string
-			Offset int
-			Rumus  string
+		// Profil yang sedang dibuka membawa kurupnya sendiri.
+		s := settings
+		if profilAktif != nil {
+			s = profilAktif.pengaturan(settings)
+			lblProfil.SetText(profilAktif.String())
+			lblProfil.Show()
+			if profilAktif.Catatan != "" {
+				resultBox.Add(createInfoCard("Catatan", profilAktif.Catatan))
+			}
+		} else {
+			lblProfil.Hide()
 		}
-		events := []Event{
-			{"Geblag", "Hari H", 0, "Jisarji"},
-			{"Nelung", "3 Hari", 2, "Lusarlu"},
-			{"Mitung", "7 Hari", 6, "Tusarro"},
-			{"Matang", "40 Hari", 39, "Masarma"},
-			{"Nyatus", "100 Hari", 99, "Rosarma"},
-			{"Pendhak I", "1 Tahun", 353, "Patsarpat"},
-			{"Pendhak II", "2 Tahun", 707, "Rosarpat"},
-			{"Nyewu", "1000 Hari", 999, "Nemsarmo"},
+		if catatanMundur != "" {
+			resultBox.Add(createInfoCard("Hitung Mundur", catatanMundur))
 		}
 
 		now := time.Now()
 		now = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
-		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
-
-		for _, e := range events {
-			targetDate := t.AddDate(0, 0, e.Offset)
-			targetDate = time.Date(targetDate.Year(), targetDate.Month(), targetDate.Day(), 0, 0, 0, 0, targetDate.Location())
-			diff := int(targetDate.Sub(now).Hours() / 24)
-			status := 3
-			if diff < 0 {
-				status = 1
-			} else if diff == 0 {
-				status = 2
+		asli := t
+		t, geser := s.hariJawa(t)
+		if geser {
+			resultBox.Add(createGeserNote(asli, t, s, myWindow.Canvas()))
+		}
+
+		var names []string
+		var dates []time.Time
+		cards := container.NewVBox()
+		jadwal := s.hitungJadwal(t)
+
+		// Ringkasan biaya di bawah kartu, diperbarui setiap ada catatan baru.
+		ringkasanBiaya := container.NewStack()
+		refreshBiaya := func() {
+			if profilAktif == nil {
+				return
 			}
-			desc := DeskripsiFase[e.Name]
-			card := createCard(e.Name, e.Sub, formatIndoDate(targetDate), formatWeton(targetDate), e.Rumus, desc, status, diff, myWindow.Canvas())
-			resultBox.Add(card)
-			resultBox.Add(layout.NewSpacer())
+			ringkasanBiaya.Objects = []fyne.CanvasObject{createBiayaRingkasan(myWindow, myApp.Preferences(), *profilAktif, jadwal)}
+			ringkasanBiaya.Refresh()
 		}
+		refreshBiaya()
+
+		for _, e := range jadwal {
+			var extra fyne.CanvasObject
+			if profilAktif != nil {
+				extra = container.NewHBox(
+					createChecklistButton(myWindow.Canvas(), myApp.Preferences(), *profilAktif, e.Acara.Nama),
+					createBiayaButton(myWindow.Canvas(), myApp.Preferences(), *profilAktif, e.Acara.Nama, refreshBiaya),
+				)
+			}
+			cards.Add(createJadwalCard(e, s.Kurup, now, extra, myWindow.Canvas()))
+			cards.Add(layout.NewSpacer())
+			names = append(names, e.Acara.Nama)
+			dates = append(dates, e.Tanggal)
+		}
+
+		lblKurup := canvas.NewText(formatKurup(s.Kurup), ColorTextGrey)
+		lblKurup.TextSize = 11
+		lblKurup.TextStyle = fyne.TextStyle{Italic: true}
+		btnCompare := widget.NewButton("Bandingkan Kurup", func() {
+			showModalCard(myWindow.Canvas(), "Perbandingan Kurup", createKurupTable(names, dates))
+		})
+		btnCompare.Importance = widget.LowImportance
+
+		ekspor := jadwalEkspor{Geblag: t, Kurup: s.Kurup, Pakem: s.pakem().Nama, Jadwal: jadwal}
+		if profilAktif != nil {
+			ekspor.ProfilID = profilAktif.ID
+			ekspor.Nama = profilAktif.String()
+		}
+		btnEkspor := widget.NewButtonWithIcon("", theme.DocumentSaveIcon(), func() {
+			showEksporICS(myWindow, ekspor, s.Pengingat)
+		})
+		btnEkspor.Importance = widget.LowImportance
+		btnBagikan := widget.NewButtonWithIcon("Bagikan", theme.MailSendIcon(), func() {
+			showBagikanPopup(myApp, myWindow.Canvas(), ekspor)
+		})
+		btnBagikan.Importance = widget.LowImportance
+		btnGambar := widget.NewButtonWithIcon("", theme.FileImageIcon(), func() {
+			showEksporGambar(myWindow, ekspor)
+		})
+		btnGambar.Importance = widget.LowImportance
+		btnUndangan := widget.NewButtonWithIcon("", theme.MailComposeIcon(), func() {
+			showUndanganPopup(myApp, myWindow, ekspor)
+		})
+		btnUndangan.Importance = widget.LowImportance
+
+		lblPakem := canvas.NewText("Pakem: "+s.pakem().Nama, ColorTextGrey)
+		lblPakem.TextSize = 11
+		lblPakem.TextStyle = fyne.TextStyle{Italic: true}
+
+		resultBox.Add(container.NewBorder(nil, nil, container.NewVBox(lblPakem, lblKurup), container.NewHBox(btnBagikan, btnUndangan, btnGambar, btnEkspor, btnCompare)))
+		resultBox.Add(cards)
+		resultBox.Add(ringkasanBiaya)
 		resultBox.Refresh()
 	}
 
@@ -882,7 +1204,7 @@ func main() {
 
 	// CALL CREATE CALENDAR POPUP DENGAN REALTIME CALLBACK
 	btnOpenCalc.OnTapped = func() {
-		createCalendarPopup(myWindow.Canvas(), calcDate,
+		createCalendarPopup(myWindow.Canvas(), calcDate, settings.Kurup,
 			// Callback 1: Realtime Update
 			func(realtimeDate time.Time) {
 				updateDateLabel(realtimeDate)
@@ -890,12 +1212,36 @@ func main() {
 			// Callback 2: Final Selection
 			func(finalDate time.Time) {
 				calcDate = finalDate
+				profilAktif = nil
+				catatanMundur = ""
 				performCalculation(calcDate)
+				if hariJawa, geser := settings.hariJawa(calcDate); geser {
+					showGeserDialog(calcDate, hariJawa, settings, myWindow.Canvas())
+				}
 			},
 		)
 	}
 
-	inputRow := container.NewBorder(nil, nil, nil, nil, lblSelectedDate)
+	bukaProfil := func(pr profil) {
+		profilAktif = &pr
+		catatanMundur = ""
+		calcDate = pr.Geblag
+		performCalculation(calcDate)
+	}
+	btnProfil := widget.NewButtonWithIcon("Profil", theme.AccountIcon(), func() {
+		showProfilPopup(myWindow, myApp.Preferences(), settings, bukaProfil)
+	})
+
+	btnMundur := widget.NewButtonWithIcon("Mundur", theme.HistoryIcon(), func() {
+		showHitungMundurPopup(myWindow.Canvas(), settings, func(geblag time.Time, catatan string) {
+			profilAktif = nil
+			catatanMundur = catatan
+			calcDate = geblag
+			performCalculation(calcDate)
+		})
+	})
+
+	inputRow := container.NewBorder(nil, nil, nil, nil, container.NewVBox(lblProfil, lblSelectedDate))
 	inputCardBg := canvas.NewRectangle(ColorCardBg)
 	inputCardBg.CornerRadius = 8
 
@@ -905,7 +1251,7 @@ func main() {
 			lblDateTitle,
 			inputRow,
 			layout.NewSpacer(),
-			container.NewCenter(btnOpenCalc),
+			container.NewCenter(container.NewHBox(btnOpenCalc, btnProfil, btnMundur)),
 		)),
 	)
 
@@ -922,7 +1268,7 @@ func main() {
 	wetonResultBox := container.NewVBox()
 	wetonScrollArea := container.NewVScroll(container.NewPadded(wetonResultBox))
 
-	wetonDate := time.Now()
+	wetonDate := today
 	lblWetonTitle := canvas.NewText("Tanggal Lahir:", ColorTextGrey)
 	lblWetonTitle.TextSize = 12
 
@@ -931,17 +1277,32 @@ func main() {
 	lblSelectedWetonDate.TextStyle = fyne.TextStyle{Bold: true}
 
 	updateWetonDateLabel := func(t time.Time) {
-		lblSelectedWetonDate.SetText(formatIndoDate(t))
+		lblSelectedWetonDate.SetText(formatIndoDateTime(t))
 	}
 	updateWetonDateLabel(wetonDate)
 
 	performWetonCheck := func(t time.Time) {
 		updateWetonDateLabel(t)
 		wetonResultBox.Objects = nil
-		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
-		neptuStr := calculateNeptu(t)
-		card := createCard("Hasil Weton", neptuStr, formatIndoDate(t), formatWeton(t), "", "", 4, 0, nil)
+		asli := t
+		t, geser := settings.hariJawa(t)
+		if geser {
+			wetonResultBox.Add(createGeserNote(asli, t, settings, myWindow.Canvas()))
+		}
+		neptuStr := formatNeptu(jawa.WetonOf(t))
+		card := createCard("Hasil Weton", neptuStr, formatIndoDate(t), formatWeton(t, settings.Kurup), "", "", "", 4, 0, nil, nil, formatTahunJawa(t, settings.Kurup), formatWuku(t), formatMangsa(t), formatKurup(settings.Kurup))
 		wetonResultBox.Add(card)
+		wetonResultBox.Add(layout.NewSpacer())
+		wetonResultBox.Add(createInfoCard(formatWuku(t), formatInfoWuku(jawa.WukuOf(t))))
+		wetonResultBox.Add(layout.NewSpacer())
+
+		compareBg := canvas.NewRectangle(ColorCardBg)
+		compareBg.CornerRadius = 10
+		lblCompare := canvas.NewText("Tanggal Jawa per Kurup", ColorTextWhite)
+		lblCompare.TextSize = 12
+		lblCompare.TextStyle = fyne.TextStyle{Bold: true}
+		compareTable := createKurupTable([]string{"Lahir"}, []time.Time{t})
+		wetonResultBox.Add(container.NewStack(compareBg, container.NewPadded(container.NewVBox(lblCompare, compareTable))))
 		wetonResultBox.Refresh()
 	}
 
@@ -951,7 +1312,7 @@ func main() {
 
 	// CALL CREATE CALENDAR POPUP DENGAN REALTIME CALLBACK (WETON)
 	btnOpenWeton.OnTapped = func() {
-		createCalendarPopup(myWindow.Canvas(), wetonDate,
+		createCalendarPopup(myWindow.Canvas(), wetonDate, settings.Kurup,
 			// Callback 1: Realtime Update
 			func(realtimeDate time.Time) {
 				updateWetonDateLabel(realtimeDate)
@@ -960,6 +1321,9 @@ func main() {
 			func(finalDate time.Time) {
 				wetonDate = finalDate
 				performWetonCheck(wetonDate)
+				if hariJawa, geser := settings.hariJawa(wetonDate); geser {
+					showGeserDialog(wetonDate, hariJawa, settings, myWindow.Canvas())
+				}
 			},
 		)
 	}
@@ -984,10 +1348,321 @@ func main() {
 		wetonScrollArea,
 	)
 
+	// =======================================================
+	// BAGIAN 3: TAB WETON JODOH
+	// =======================================================
+
+	jodohResultBox := container.NewVBox()
+	jodohScrollArea := container.NewVScroll(container.NewPadded(jodohResultBox))
+
+	jodohDates := []time.Time{today, today}
+	jodohTitles := []string{"Pihak Pria", "Pihak Wanita"}
+	jodohSelected := []bool{false, false}
+
+	performJodohCheck := func() {
+		jodohResultBox.Objects = nil
+		var wetons []jawa.Weton
+		for i, d := range jodohDates {
+			t, geser := settings.hariJawa(d)
+			w := jawa.WetonOf(t)
+			wetons = append(wetons, w)
+			if geser {
+				jodohResultBox.Add(createGeserNote(d, t, settings, myWindow.Canvas()))
+			}
+			jodohResultBox.Add(createCard(jodohTitles[i], formatNeptu(w), formatIndoDate(t), formatWeton(t, settings.Kurup), "", "", "", 4, 0, nil, nil))
+			jodohResultBox.Add(layout.NewSpacer())
+		}
+
+		hasil := jawa.CekJodoh(wetons[0], wetons[1])
+		baik := 0
+		for _, p := range hasil {
+			if p.Baik {
+				baik++
+			}
+		}
+		jodohResultBox.Add(createInfoCard(
+			fmt.Sprintf("Jumlah Neptu: %d + %d = %d", wetons[0].Neptu(), wetons[1].Neptu(), wetons[0].Neptu()+wetons[1].Neptu()),
+			fmt.Sprintf("%d dari %d petungan menunjukkan hasil baik. Petungan hanyalah pertimbangan; yang utama adalah niat baik dan doa kedua keluarga.", baik, len(hasil)),
+		))
+		for _, p := range hasil {
+			jodohResultBox.Add(layout.NewSpacer())
+			jodohResultBox.Add(createInfoCard(formatPetungan(p), p.Keterangan))
+		}
+		jodohResultBox.Refresh()
+	}
+
+	jodohInputs := container.NewVBox()
+	for i := range jodohDates {
+		idx := i
+		lblTitle := canvas.NewText(jodohTitles[idx]+":", ColorTextGrey)
+		lblTitle.TextSize = 12
+		btnDate := widget.NewButtonWithIcon("Pilih Tanggal Lahir", theme.CalendarIcon(), nil)
+		btnDate.OnTapped = func() {
+			createCalendarPopup(myWindow.Canvas(), jodohDates[idx], settings.Kurup,
+				func(realtimeDate time.Time) {
+					btnDate.SetText(formatIndoDateTime(realtimeDate))
+				},
+				func(finalDate time.Time) {
+					jodohDates[idx] = finalDate
+					jodohSelected[idx] = true
+					btnDate.SetText(formatIndoDateTime(finalDate))
+				},
+			)
+		}
+		jodohInputs.Add(container.NewBorder(nil, nil, lblTitle, nil, btnDate))
+	}
+
+	btnCekJodoh := widget.NewButton("Cek Kecocokan", func() {
+		if !jodohSelected[0] || !jodohSelected[1] {
+			jodohResultBox.Objects = []fyne.CanvasObject{createInfoCard("Belum Lengkap", "Pilih tanggal lahir kedua pihak terlebih dahulu.")}
+			jodohResultBox.Refresh()
+			return
+		}
+		performJodohCheck()
+	})
+	btnCekJodoh.Importance = widget.HighImportance
+	btnCekJodoh.Icon = theme.ConfirmIcon()
+
+	inputCardBgJodoh := canvas.NewRectangle(ColorCardBg)
+	inputCardBgJodoh.CornerRadius = 8
+
+	inputSectionJodoh := container.NewStack(
+		inputCardBgJodoh,
+		container.NewPadded(container.NewVBox(
+			jodohInputs,
+			container.NewCenter(btnCekJodoh),
+		)),
+	)
+
+	tabContentJodoh := container.NewBorder(
+		container.NewPadded(inputSectionJodoh),
+		nil, nil, nil,
+		jodohScrollArea,
+	)
+
+	// =======================================================
+	// BAGIAN 4: TAB HARI BAIK
+	// =======================================================
+
+	hariBaikResultBox := container.NewVBox()
+
+	hariBaikLahir := today
+	hariBaikLahirSelected := false
+	hariBaikDari := today
+	hariBaikSampai := today.AddDate(0, 3, 0)
+	var hariBaikGeblag []time.Time
+
+	selHajat := widget.NewSelect(jawa.NamaHajat, nil)
+	selHajat.SetSelectedIndex(int(jawa.HajatNikah))
+
+	// newDateButton membuat tombol yang membuka kalender dan menampilkan
+	// tanggal terpilih sebagai teksnya.
+	newDateButton := func(label string, date *time.Time, onSelected func()) *widget.Button {
+		btn := widget.NewButtonWithIcon(label, theme.CalendarIcon(), nil)
+		btn.OnTapped = func() {
+			createCalendarPopup(myWindow.Canvas(), *date, settings.Kurup,
+				func(realtimeDate time.Time) {
+					btn.SetText(formatIndoDateTime(realtimeDate))
+				},
+				func(finalDate time.Time) {
+					*date = finalDate
+					btn.SetText(formatIndoDateTime(finalDate))
+					if onSelected != nil {
+						onSelected()
+					}
+				},
+			)
+		}
+		return btn
+	}
+
+	btnLahir := newDateButton("Pilih Tanggal Lahir", &hariBaikLahir, func() { hariBaikLahirSelected = true })
+	btnDari := newDateButton(formatIndoDate(hariBaikDari), &hariBaikDari, nil)
+	btnSampai := newDateButton(formatIndoDate(hariBaikSampai), &hariBaikSampai, nil)
+
+	geblagList := container.NewVBox()
+	var refreshGeblagList func()
+	refreshGeblagList = func() {
+		geblagList.Objects = nil
+		for i, g := range hariBaikGeblag {
+			idx := i
+			t, _ := settings.hariJawa(g)
+			lbl := canvas.NewText(fmt.Sprintf("%s (%s)", formatIndoDate(t), jawa.WetonOf(t)), ColorTextWhite)
+			lbl.TextSize = 12
+			btnDel := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				hariBaikGeblag = append(hariBaikGeblag[:idx], hariBaikGeblag[idx+1:]...)
+				refreshGeblagList()
+			})
+			btnDel.Importance = widget.LowImportance
+			geblagList.Add(container.NewBorder(nil, nil, nil, btnDel, lbl))
+		}
+		geblagList.Refresh()
+	}
+	btnAddGeblag := widget.NewButtonWithIcon("Tambah Geblag Orang Tua", theme.ContentAddIcon(), func() {
+		createCalendarPopup(myWindow.Canvas(), today, settings.Kurup, nil, func(finalDate time.Time) {
+			hariBaikGeblag = append(hariBaikGeblag, finalDate)
+			refreshGeblagList()
+		})
+	})
+	btnAddGeblag.Importance = widget.LowImportance
+
+	performHariBaikSearch := func() {
+		hariBaikResultBox.Objects = nil
+		if !hariBaikLahirSelected {
+			hariBaikResultBox.Add(createInfoCard("Belum Lengkap", "Pilih tanggal lahir Anda terlebih dahulu."))
+			hariBaikResultBox.Refresh()
+			return
+		}
+
+		lahir, _ := settings.hariJawa(hariBaikLahir)
+		kriteria := jawa.KriteriaHariBaik{
+			Hajat: jawa.Hajat(selHajat.SelectedIndex()),
+			Weton: jawa.WetonOf(lahir),
+			Kurup: settings.Kurup,
+		}
+		for _, g := range hariBaikGeblag {
+			t, _ := settings.hariJawa(g)
+			kriteria.Geblag = append(kriteria.Geblag, jawa.WetonOf(t))
+		}
+
+		hasil := jawa.CariHariBaik(hariBaikDari, hariBaikSampai, kriteria)
+		if len(hasil) == 0 {
+			hariBaikResultBox.Add(createInfoCard("Tidak Ditemukan", "Tidak ada hari baik pada rentang tanggal tersebut. Coba perpanjang rentangnya."))
+			hariBaikResultBox.Refresh()
+			return
+		}
+		const maxHasil = 30
+		if len(hasil) > maxHasil {
+			hasil = hasil[:maxHasil]
+		}
+
+		hariBaikResultBox.Add(createInfoCard(
+			fmt.Sprintf("Hari Baik untuk %s", kriteria.Hajat),
+			fmt.Sprintf("Weton Anda %s (neptu %d). Berikut %d tanggal terbaik, ketuk untuk melihat alasannya.", kriteria.Weton, kriteria.Weton.Neptu(), len(hasil)),
+		))
+		for i, h := range hasil {
+			diff := int(h.Tanggal.Sub(today).Hours() / 24)
+			sub := fmt.Sprintf("Skor %+d · %d hari lagi", h.Skor, diff)
+			if diff == 0 {
+				sub = fmt.Sprintf("Skor %+d · hari ini", h.Skor)
+			}
+			hariBaikResultBox.Add(layout.NewSpacer())
+			hariBaikResultBox.Add(createCard(fmt.Sprintf("#%d %s", i+1, h.Weton), sub, formatIndoDate(h.Tanggal), formatWeton(h.Tanggal, settings.Kurup), "", strings.Join(h.Alasan, "\n\n"), "", 4, diff, nil, myWindow.Canvas(), formatWuku(h.Tanggal)))
+		}
+		hariBaikResultBox.Refresh()
+	}
+
+	btnCariHariBaik := widget.NewButton("Cari Hari Baik", performHariBaikSearch)
+	btnCariHariBaik.Importance = widget.HighImportance
+	btnCariHariBaik.Icon = theme.SearchIcon()
+
+	newFieldLabel := func(text string) *canvas.Text {
+		lbl := canvas.NewText(text, ColorTextGrey)
+		lbl.TextSize = 12
+		return lbl
+	}
+
+	inputCardBgHariBaik := canvas.NewRectangle(ColorCardBg)
+	inputCardBgHariBaik.CornerRadius = 8
+
+	inputSectionHariBaik := container.NewStack(
+		inputCardBgHariBaik,
+		container.NewPadded(container.NewVBox(
+			container.NewBorder(nil, nil, newFieldLabel("Hajat:"), nil, selHajat),
+			container.NewBorder(nil, nil, newFieldLabel("Lahir:"), nil, btnLahir),
+			container.NewGridWithColumns(2,
+				container.NewVBox(newFieldLabel("Dari:"), btnDari),
+				container.NewVBox(newFieldLabel("Sampai:"), btnSampai),
+			),
+			geblagList,
+			btnAddGeblag,
+			container.NewCenter(btnCariHariBaik),
+		)),
+	)
+
+	tabContentHariBaik := container.NewVScroll(container.NewPadded(container.NewVBox(
+		inputSectionHariBaik,
+		hariBaikResultBox,
+	)))
+
+	// =======================================================
+	// BAGIAN 5: TAB HARI INI
+	// =======================================================
+
+	todayBox := container.NewVBox()
+	tabContentToday := container.NewVScroll(container.NewPadded(todayBox))
+
+	performTodayOverview := func() {
+		todayBox.Objects = nil
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		// Setelah maghrib hanya hari Jawa (weton, tanggal Jawa, wuku) yang
+		// bergeser. Tanggal Masehi dan mangsa tetap mengikuti hari ini.
+		t, _ := settings.hariJawa(now)
+		w := jawa.WetonOf(t)
+		m := jawa.MangsaOf(today)
+
+		card := createCard("Hari Ini", formatNeptu(w), formatIndoDate(today), formatWeton(t, settings.Kurup), "", "", "", 4, 0, nil, nil, formatTahunJawa(t, settings.Kurup), formatWuku(t), formatMangsa(today))
+		todayBox.Add(card)
+		todayBox.Add(layout.NewSpacer())
+		if !t.Equal(today) {
+			todayBox.Add(createGeserNote(now, t, settings, myWindow.Canvas()))
+			todayBox.Add(layout.NewSpacer())
+		}
+		todayBox.Add(createInfoCard("Mangsa "+m.NamaMangsa(), formatInfoMangsa(m)))
+		todayBox.Add(layout.NewSpacer())
+		todayBox.Add(createInfoCard(formatWuku(t), formatInfoWuku(jawa.WukuOf(t))))
+		todayBox.Refresh()
+	}
+	performTodayOverview()
+
+	// =======================================================
+	// BAGIAN 6: TAB AGENDA
+	// =======================================================
+
+	agendaBox := container.NewVBox()
+	tabContentAgenda := container.NewVScroll(container.NewPadded(agendaBox))
+
+	// Diisi setelah tab dibuat, untuk pindah ke tab Hitung Selamatan.
+	var selectTabSelamatan func()
+
+	performAgenda := func() {
+		agendaBox.Objects = nil
+		now := time.Now()
+		now = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		items := susunAgenda(loadProfil(myApp.Preferences()), settings, now)
+		if len(items) == 0 {
+			agendaBox.Add(createInfoCard("Agenda Kosong", "Belum ada acara. Simpan profil almarhum/almarhumah lewat tombol Profil di tab Hitung Selamatan, maka seluruh jadwalnya muncul di sini."))
+		} else {
+			agendaBox.Add(createAgendaView(items, now, func(pr profil) {
+				bukaProfil(pr)
+				selectTabSelamatan()
+			}))
+		}
+		agendaBox.Refresh()
+	}
+
 	// =======================================================
 	// FOOTER SETUP
 	// =======================================================
 
+	styleRumus := widget.RichTextStyle{
+		ColorName: "red",
+		Inline:    true,
+		TextStyle: fyne.TextStyle{Italic: true, Bold: true},
+	}
+	segRumusAwal := &widget.TextSegment{Style: styleRumus}
+	segRumusAkhir := &widget.TextSegment{Style: styleRumus}
+	refreshNoteRumus := func() {
+		awal, akhir, ok := rentangRumus(settings.pakem())
+		if !ok {
+			awal, akhir = selamatan.RumusOf(0), selamatan.RumusOf(0)
+		}
+		segRumusAwal.Text = strings.ToLower(awal.String()) + " "
+		segRumusAkhir.Text = strings.ToLower(akhir.String()) + " "
+	}
+	refreshNoteRumus()
+
 	richNoteSelamatan := widget.NewRichText(
 		&widget.TextSegment{
 			Text: "Notes: ",
@@ -1004,14 +1679,7 @@ func main() {
 				TextStyle: fyne.TextStyle{Italic: true},
 			},
 		},
-		&widget.TextSegment{
-			Text: "lusarlu ",
-			Style: widget.RichTextStyle{
-				ColorName: "red",
-				Inline:    true,
-				TextStyle: fyne.TextStyle{Italic: true, Bold: true},
-			},
-		},
+		segRumusAwal,
 		&widget.TextSegment{
 			Text: "hingga ",
 			Style: widget.RichTextStyle{
@@ -1019,14 +1687,7 @@ func main() {
 				TextStyle: fyne.TextStyle{Italic: true},
 			},
 		},
-		&widget.TextSegment{
-			Text: "nemsarmo ",
-			Style: widget.RichTextStyle{
-				ColorName: "red",
-				Inline:    true,
-				TextStyle: fyne.TextStyle{Italic: true, Bold: true},
-			},
-		},
+		segRumusAkhir,
 		&widget.TextSegment{
 			Text: ". Silahkan klik pada hasil hari/pasaran untuk melihat rumus dan filosofinya.",
 			Style: widget.RichTextStyle{
@@ -1081,10 +1742,43 @@ func main() {
 	tabs := container.NewAppTabs(
 		container.NewTabItem("Hitung Selamatan", tabContentSelamatan),
 		container.NewTabItem("Cek Weton Lahir", tabContentWeton),
+		container.NewTabItem("Weton Jodoh", tabContentJodoh),
+		container.NewTabItem("Hari Baik", tabContentHariBaik),
+		container.NewTabItem("Hari Ini", tabContentToday),
+		container.NewTabItem("Agenda", tabContentAgenda),
 	)
+	selectTabSelamatan = func() { tabs.SelectIndex(0) }
 	tabs.SetTabLocation(container.TabLocationTop)
 
+	onSettingsChanged = func() {
+		if len(resultBox.Objects) > 0 {
+			performCalculation(calcDate)
+		}
+		if len(wetonResultBox.Objects) > 0 {
+			performWetonCheck(wetonDate)
+		}
+		if jodohSelected[0] && jodohSelected[1] && len(jodohResultBox.Objects) > 0 {
+			performJodohCheck()
+		}
+		if len(hariBaikResultBox.Objects) > 0 {
+			refreshGeblagList()
+			performHariBaikSearch()
+		}
+		performTodayOverview()
+		if len(agendaBox.Objects) > 0 {
+			performAgenda()
+		}
+		refreshNoteRumus()
+		richNoteSelamatan.Refresh()
+	}
+
 	tabs.OnSelected = func(i *container.TabItem) {
+		if i.Text == "Hari Ini" {
+			performTodayOverview()
+		}
+		if i.Text == "Agenda" {
+			performAgenda()
+		}
 		noteContainer.Objects = nil
 		if i.Text == "Hitung Selamatan" {
 			noteContainer.Add(richNoteSelamatan)
@@ -1104,6 +1798,7 @@ func main() {
 	myWindow.SetContent(container.NewStack(imgBg, mainContent))
 
 	checkForUpdates(myWindow.Canvas(), myApp)
+	mulaiPengingat(myApp, &settings)
 
 	myWindow.ShowAndRun()
 }
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// HITUNG MUNDUR TANGGAL GEBLAG
+// ==========================================
+
+// showHitungMundurPopup meminta satu acara dari pakem beserta tanggalnya,
+// lalu mencari tanggal geblag. Bila ada beberapa kemungkinan, pengguna
+// memilih salah satunya. onResult menerima geblag dan penjelasannya.
+func showHitungMundurPopup(parentCanvas fyne.Canvas, settings appSettings, onResult func(geblag time.Time, catatan string)) {
+	var daftar []selamatan.Acara
+	var nama []string
+	for _, a := range append(append([]selamatan.Acara{}, settings.pakem().Acara...), settings.AcaraKustom...) {
+		if a.Hari == 0 && a.TahunJawa == 0 {
+			continue // geblag sendiri, tidak perlu dihitung mundur
+		}
+		daftar = append(daftar, a)
+		nama = append(nama, fmt.Sprintf("%s (%s)", a.Nama, a.Sub))
+	}
+
+	label := func(s string) *canvas.Text {
+		t := canvas.NewText(s, ColorTextGrey)
+		t.TextSize = 12
+		return t
+	}
+
+	selAcara := widget.NewSelect(nama, nil)
+	selAcara.SetSelectedIndex(0)
+
+	tanggal := time.Now()
+	tanggal = time.Date(tanggal.Year(), tanggal.Month(), tanggal.Day(), 0, 0, 0, 0, tanggal.Location())
+	lblTanggal := widget.NewLabel(formatIndoDate(tanggal))
+	lblTanggal.TextStyle = fyne.TextStyle{Bold: true}
+	btnTanggal := widget.NewButtonWithIcon("", theme.CalendarIcon(), func() {
+		createCalendarPopup(parentCanvas, tanggal, settings.Kurup, nil, func(t time.Time) {
+			tanggal = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+			lblTanggal.SetText(formatIndoDate(tanggal))
+		})
+	})
+
+	hasil := container.NewVBox()
+	note := widget.NewLabel("Pilih acara yang tanggalnya diketahui, misalnya nyatus, lalu tanggal pelaksanaannya. Tanggal geblag dan seluruh jadwal akan dihitung ulang menurut pakem " + settings.pakem().Nama + ".")
+	note.Wrapping = fyne.TextWrapWord
+	note.TextStyle = fyne.TextStyle{Italic: true}
+
+	form := container.NewVBox(
+		note,
+		label("Acara:"),
+		selAcara,
+		label("Tanggal acara:"),
+		container.NewBorder(nil, nil, nil, btnTanggal, lblTanggal),
+		hasil,
+		layout.NewSpacer(),
+	)
+
+	var popup *widget.PopUp
+	pilih := func(g time.Time, a selamatan.Acara) {
+		popup.Hide()
+		onResult(g, fmt.Sprintf("Geblag dihitung mundur dari %s pada %s.", a.Nama, formatIndoDate(tanggal)))
+	}
+
+	btnHitung := widget.NewButton("Hitung", func() {
+		if selAcara.SelectedIndex() < 0 {
+			return
+		}
+		a := daftar[selAcara.SelectedIndex()]
+		opsi := selamatan.Opsi{Kurup: settings.Kurup, PendhakJawa: settings.PendhakJawa}
+		geblag := a.GeblagDari(tanggal, opsi)
+
+		hasil.Objects = nil
+		switch len(geblag) {
+		case 0:
+			lblErr := widget.NewLabel(fmt.Sprintf("Tidak ada tanggal geblag yang membuat %s jatuh pada %s.", a.Nama, formatIndoDate(tanggal)))
+			lblErr.Wrapping = fyne.TextWrapWord
+			hasil.Add(lblErr)
+		case 1:
+			pilih(geblag[0], a)
+			return
+		default:
+			hasil.Add(label("Ada beberapa kemungkinan geblag:"))
+			for _, g := range geblag {
+				g := g
+				btn := widget.NewButton(formatIndoDate(g)+" · "+formatWeton(g, settings.Kurup), func() { pilih(g, a) })
+				btn.Importance = widget.LowImportance
+				hasil.Add(btn)
+			}
+		}
+		hasil.Refresh()
+	})
+	btnHitung.Importance = widget.HighImportance
+
+	popup = showModalCard(parentCanvas, "Hitung Mundur Geblag", form, btnHitung)
+}
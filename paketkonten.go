@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/konten"
+)
+
+// ==========================================
+// PAKET KONTEN PENJELASAN FASE
+// ==========================================
+
+const prefKontenPaket = "konten_paket" // JSON map[nama acara]isi berkas .md
+
+// muatKonten menggabungkan konten bawaan dengan paket terpasang ke
+// KontenFase. Paket yang rusak diabaikan sehingga konten bawaan tetap dipakai.
+func muatKonten(p fyne.Preferences) {
+	var paket map[string]string
+	if err := loadJSONPref(p, prefKontenPaket, &paket); err != nil {
+		fmt.Println("Paket konten rusak, memakai konten bawaan:", err)
+		paket = nil
+	}
+	KontenFase = konten.Gabung(konten.Bawaan(), paket)
+}
+
+// formatFase menyusun Markdown penjelasan fase beserta bagian sumbernya.
+func formatFase(f konten.Fase) string {
+	md := f.Isi
+	if len(f.Sumber) > 0 {
+		md += "\n\n## " + judulSumber + "\n\n- " + strings.Join(f.Sumber, "\n- ")
+	}
+	return md
+}
+
+// infoKonten menjelaskan asal konten yang sedang dipakai.
+func infoKonten(p fyne.Preferences) string {
+	var paket map[string]string
+	if err := loadJSONPref(p, prefKontenPaket, &paket); err != nil {
+		fmt.Println("Paket konten rusak:", err)
+		paket = nil
+	}
+	if len(paket) == 0 {
+		return "Penjelasan fase: konten bawaan aplikasi."
+	}
+	var dipakai []string
+	for nama, f := range KontenFase {
+		if _, ok := paket[nama]; ok {
+			if g, err := konten.Parse([]byte(paket[nama])); err == nil && g.Versi == f.Versi {
+				dipakai = append(dipakai, fmt.Sprintf("%s v%d", nama, f.Versi))
+			}
+		}
+	}
+	sort.Strings(dipakai)
+	if len(dipakai) == 0 {
+		return "Penjelasan fase: konten bawaan aplikasi (paket terpasang lebih lama)."
+	}
+	return "Penjelasan fase dari paket konten: " + strings.Join(dipakai, ", ") + ". Fase lain memakai konten bawaan."
+}
+
+// createKontenButtons membuat tombol pasang paket konten dan kembali ke
+// konten bawaan untuk popup pengaturan. onChanged dipanggil setelah konten
+// berganti agar kartu jadwal digambar ulang.
+func createKontenButtons(win fyne.Window, prefs fyne.Preferences, onChanged func()) (*widget.Button, *widget.Button, *widget.Label) {
+	note := widget.NewLabel(infoKonten(prefs))
+	note.Wrapping = fyne.TextWrapWord
+	note.TextStyle = fyne.TextStyle{Italic: true}
+
+	ganti := func() {
+		muatKonten(prefs)
+		note.SetText(infoKonten(prefs))
+		if onChanged != nil {
+			onChanged()
+		}
+	}
+
+	btnPasang := widget.NewButtonWithIcon("Paket Konten", theme.FolderOpenIcon(), func() {
+		dialog.ShowFileOpen(func(r fyne.URIReadCloser, err error) {
+			if err != nil || r == nil {
+				return
+			}
+			defer r.Close()
+			data, err := io.ReadAll(r)
+			if err == nil {
+				var paket map[string]string
+				if paket, err = konten.BacaPaket(data); err == nil {
+					saveJSONPref(prefs, prefKontenPaket, paket)
+					ganti()
+					return
+				}
+			}
+			dialog.ShowError(err, win)
+		}, win)
+	})
+	btnPasang.Importance = widget.LowImportance
+
+	btnBawaan := widget.NewButtonWithIcon("Konten Bawaan", theme.HistoryIcon(), func() {
+		prefs.RemoveValue(prefKontenPaket)
+		ganti()
+	})
+	btnBawaan.Importance = widget.LowImportance
+
+	return btnPasang, btnBawaan, note
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ==========================================
+// PENULIS PDF SEDERHANA
+// ==========================================
+
+// Penulis PDF halaman A5 untuk undangan. Hanya memakai font standar
+// Helvetica (tanpa embed font) dengan WinAnsiEncoding, jadi huruf di luar
+// Latin-1 diganti tanda tanya. Isi yang tidak muat berlanjut ke halaman
+// berikutnya.
+
+const (
+	pdfLebar   = 420.0 // A5, satuan point
+	pdfTinggi  = 595.0
+	pdfMargin  = 42.0
+	pdfSpasi   = 1.35 // tinggi baris relatif terhadap ukuran huruf
+	pdfLebarDf = 556  // lebar huruf yang tidak ada di tabel
+)
+
+// lebarHelvetica dan lebarHelveticaBold adalah lebar glyph (per 1000 unit)
+// untuk karakter ASCII 32..126 menurut metrik AFM standar.
+var lebarHelvetica = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var lebarHelveticaBold = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// kataPDF adalah satu kata beserta gayanya.
+type kataPDF struct {
+	Teks  string
+	Tebal bool
+}
+
+// barisPDF adalah satu paragraf. Paragraf kosong menjadi jarak.
+type barisPDF struct {
+	Kata   []kataPDF
+	Ukuran float64
+	Tengah bool
+}
+
+func lebarTeks(s string, tebal bool, ukuran float64) float64 {
+	tabel := &lebarHelvetica
+	if tebal {
+		tabel = &lebarHelveticaBold
+	}
+	total := 0
+	for _, b := range []byte(s) {
+		if b >= 32 && b <= 126 {
+			total += tabel[b-32]
+		} else {
+			total += pdfLebarDf
+		}
+	}
+	return float64(total) * ukuran / 1000
+}
+
+// latin1 mengubah teks UTF-8 ke WinAnsi. Tanda petik lengkung dan pisah
+// diganti padanan ASCII, huruf lain di luar Latin-1 menjadi '?'.
+func latin1(s string) string {
+	s = strings.NewReplacer("‘", "'", "’", "'", "“", "\"", "”", "\"", "–", "-", "—", "-", "·", "-").Replace(s)
+	var b []byte
+	for _, r := range s {
+		if r < 256 {
+			b = append(b, byte(r))
+		} else {
+			b = append(b, '?')
+		}
+	}
+	return string(b)
+}
+
+func escapePDF(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`).Replace(s)
+}
+
+// pecahKata memotong kata yang lebih lebar dari satu baris (misalnya tautan
+// panjang) menjadi beberapa potongan yang masing-masing muat. s sudah berupa
+// WinAnsi, jadi satu byte satu huruf.
+func pecahKata(s string, tebal bool, ukuran, lebarMaks float64) []string {
+	var hasil []string
+	for lebarTeks(s, tebal, ukuran) > lebarMaks {
+		n := 1
+		for n < len(s) && lebarTeks(s[:n+1], tebal, ukuran) <= lebarMaks {
+			n++
+		}
+		hasil = append(hasil, s[:n])
+		s = s[n:]
+	}
+	return append(hasil, s)
+}
+
+// tulisPDF menulis paragraf ke halaman A5. Paragraf dipotong per kata agar
+// muat di antara margin dan kata yang terlalu panjang dipotong paksa. Baris
+// yang melewati margin bawah dipindah ke halaman baru.
+func tulisPDF(w io.Writer, judul string, paragraf []barisPDF) error {
+	var halaman []*bytes.Buffer
+	isi := &bytes.Buffer{}
+	halaman = append(halaman, isi)
+	y := pdfTinggi - pdfMargin
+	lebarMaks := pdfLebar - 2*pdfMargin
+
+	for _, p := range paragraf {
+		ukuran := p.Ukuran
+		if ukuran == 0 {
+			ukuran = 11
+		}
+		if len(p.Kata) == 0 {
+			y -= ukuran * pdfSpasi * 0.6
+			continue
+		}
+
+		// Pecah paragraf menjadi baris-baris yang muat.
+		var baris [][]kataPDF
+		var sekarang []kataPDF
+		lebar := 0.0
+		spasi := lebarTeks(" ", false, ukuran)
+		var kata []kataPDF
+		for _, k := range p.Kata {
+			k.Teks = latin1(k.Teks)
+			for _, potong := range pecahKata(k.Teks, k.Tebal, ukuran, lebarMaks) {
+				kata = append(kata, kataPDF{Teks: potong, Tebal: k.Tebal})
+			}
+		}
+		for _, k := range kata {
+			lk := lebarTeks(k.Teks, k.Tebal, ukuran)
+			if len(sekarang) > 0 && lebar+spasi+lk > lebarMaks {
+				baris = append(baris, sekarang)
+				sekarang, lebar = nil, 0
+			}
+			if len(sekarang) > 0 {
+				lebar += spasi
+			}
+			sekarang = append(sekarang, k)
+			lebar += lk
+		}
+		baris = append(baris, sekarang)
+
+		for _, b := range baris {
+			y -= ukuran * pdfSpasi
+			if y < pdfMargin {
+				isi = &bytes.Buffer{}
+				halaman = append(halaman, isi)
+				y = pdfTinggi - pdfMargin - ukuran*pdfSpasi
+			}
+			x := pdfMargin
+			if p.Tengah {
+				total := 0.0
+				for i, k := range b {
+					if i > 0 {
+						total += spasi
+					}
+					total += lebarTeks(k.Teks, k.Tebal, ukuran)
+				}
+				x = (pdfLebar - total) / 2
+			}
+			for i, k := range b {
+				teks := k.Teks
+				if i < len(b)-1 {
+					teks += " "
+				}
+				font := "F1"
+				if k.Tebal {
+					font = "F2"
+				}
+				fmt.Fprintf(isi, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, ukuran, x, y, escapePDF(teks))
+				x += lebarTeks(teks, k.Tebal, ukuran)
+			}
+		}
+	}
+
+	// Objek 1-5 tetap, lalu setiap halaman memakai dua objek: halaman dan
+	// isinya.
+	var kids []string
+	for i := range halaman {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 6+2*i))
+	}
+	objek := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(halaman)),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (kalender-selamatan) >>", escapePDF(latin1(judul))),
+	}
+	for i, h := range halaman {
+		objek = append(objek,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Contents %d 0 R /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> >>", pdfLebar, pdfTinggi, 7+2*i),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", h.Len(), h.String()),
+		)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offset := make([]int, len(objek))
+	for i, o := range objek {
+		offset[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objek)+1)
+	for _, off := range offset {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objek)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
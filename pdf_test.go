@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTulisPDF(t *testing.T) {
+	paragrafKata := func(n int) []barisPDF {
+		var hasil []barisPDF
+		for i := 0; i < n; i++ {
+			hasil = append(hasil, barisPDF{Kata: []kataPDF{{Teks: fmt.Sprintf("Baris%03d", i), Tebal: i%2 == 0}}})
+		}
+		return hasil
+	}
+	tests := []struct {
+		nama    string
+		baris   int
+		halaman int
+	}{
+		{"pendek", 5, 1},
+		{"pas", 34, 1},
+		{"dua halaman", 60, 2},
+		{"tiga halaman", 100, 3},
+	}
+	for _, tt := range tests {
+		var b bytes.Buffer
+		if err := tulisPDF(&b, "Undangan Mitung", paragrafKata(tt.baris)); err != nil {
+			t.Fatal(err)
+		}
+		pdf := b.String()
+
+		if want := fmt.Sprintf("/Count %d", tt.halaman); !strings.Contains(pdf, want) {
+			t.Errorf("%s: tidak ada %q", tt.nama, want)
+		}
+		if got := strings.Count(pdf, "/Type /Page "); got != tt.halaman {
+			t.Errorf("%s: %d objek halaman, want %d", tt.nama, got, tt.halaman)
+		}
+		// Tidak ada baris yang hilang.
+		for i := 0; i < tt.baris; i++ {
+			if !strings.Contains(pdf, fmt.Sprintf("(Baris%03d) Tj", i)) {
+				t.Errorf("%s: Baris%03d tidak tertulis", tt.nama, i)
+			}
+		}
+		// Setiap teks berada di dalam margin halaman.
+		for _, m := range regexp.MustCompile(`Tf [0-9.]+ ([0-9.-]+) Td`).FindAllStringSubmatch(pdf, -1) {
+			if y, _ := strconv.ParseFloat(m[1], 64); y < pdfMargin || y > pdfTinggi-pdfMargin {
+				t.Errorf("%s: teks di y=%.2f, di luar margin", tt.nama, y)
+			}
+		}
+		// Tabel xref menunjuk awal setiap objek.
+		xref := regexp.MustCompile(`(?m)^(\d{10}) 00000 n $`).FindAllStringSubmatch(pdf, -1)
+		for i, m := range xref {
+			off, _ := strconv.Atoi(m[1])
+			if !strings.HasPrefix(pdf[off:], fmt.Sprintf("%d 0 obj", i+1)) {
+				t.Errorf("%s: offset objek %d salah", tt.nama, i+1)
+			}
+		}
+	}
+}
+
+func TestTulisPDFKataPanjang(t *testing.T) {
+	panjang := strings.Repeat("W", 120)
+	tautan := "https://maps.example.com/rumah-duka?lokasi=" + strings.Repeat("abc123", 20)
+	paragraf := []barisPDF{
+		{Kata: []kataPDF{{Teks: "Alamat:"}, {Teks: panjang, Tebal: true}, {Teks: "selesai"}}},
+		{Kata: []kataPDF{{Teks: tautan}}},
+	}
+	var b bytes.Buffer
+	if err := tulisPDF(&b, "Undangan", paragraf); err != nil {
+		t.Fatal(err)
+	}
+
+	pola := regexp.MustCompile(`BT /(F[12]) ([0-9.]+) Tf ([0-9.-]+) [0-9.-]+ Td \((.*)\) Tj ET`)
+	var semua strings.Builder
+	for _, m := range pola.FindAllStringSubmatch(b.String(), -1) {
+		ukuran, _ := strconv.ParseFloat(m[2], 64)
+		x, _ := strconv.ParseFloat(m[3], 64)
+		teks := strings.TrimRight(m[4], " ")
+		if kanan := x + lebarTeks(teks, m[1] == "F2", ukuran); kanan > pdfLebar-pdfMargin+0.01 {
+			t.Errorf("%q berakhir di x=%.2f, melewati margin kanan %.2f", teks, kanan, pdfLebar-pdfMargin)
+		}
+		semua.WriteString(teks)
+	}
+	// Potongan kata tetap lengkap dan berurutan.
+	for _, kata := range []string{panjang, tautan} {
+		if !strings.Contains(semua.String(), kata) {
+			t.Errorf("kata panjang %.20s... tidak tertulis utuh", kata)
+		}
+	}
+}
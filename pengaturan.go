@@ -0,0 +1,476 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/bacaan"
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// PENGATURAN APLIKASI
+// ==========================================
+
+// Kunci Preferences untuk setiap pengaturan.
+const (
+	prefKurup         = "kurup"
+	prefAturanMaghrib = "aturan_maghrib"
+	prefJamMaghrib    = "jam_maghrib"
+	prefPakem         = "pakem"
+	prefPendhakJawa   = "pendhak_jawa"
+	prefPakemImpor    = "pakem_impor"  // JSON []selamatan.Pakem
+	prefAcaraKustom   = "acara_kustom" // JSON []selamatan.Acara
+	prefPengingat     = "pengingat"    // JSON []int, jarak hari pengingat
+)
+
+type appSettings struct {
+	Kurup         jawa.Kurup
+	AturanMaghrib bool          // hari Jawa berganti saat maghrib
+	JamMaghrib    time.Duration // sejak tengah malam
+
+	PakemID     string
+	PendhakJawa bool              // pendhak mengikuti ulang tahun Jawa untuk pengingat
+	PakemImpor  []selamatan.Pakem // pakem dari berkas JSON pengguna
+	AcaraKustom []selamatan.Acara // acara tambahan buatan pengguna
+
+	Pengingat []int // jarak hari pengingat sebelum acara, 0 = pagi hari H
+}
+
+func loadSettings(p fyne.Preferences) appSettings {
+	s := appSettings{
+		Kurup:         jawa.Kurup(p.IntWithFallback(prefKurup, int(jawa.Asapon))),
+		AturanMaghrib: p.BoolWithFallback(prefAturanMaghrib, true),
+		JamMaghrib:    jawa.DefaultMaghrib,
+		Pengingat:     append([]int(nil), PilihanPengingat...),
+	}
+	if s.Kurup < jawa.Aboge || s.Kurup > jawa.Anenge {
+		s.Kurup = jawa.Asapon
+	}
+	if jam, err := parseJam(p.String(prefJamMaghrib)); err == nil && jam != 0 {
+		s.JamMaghrib = jam
+	}
+
+	s.PakemID = p.String(prefPakem)
+	s.PendhakJawa = p.Bool(prefPendhakJawa)
+	if err := loadJSONPref(p, prefPakemImpor, &s.PakemImpor); err != nil {
+		fmt.Println("Pakem impor rusak:", err)
+	}
+	if err := loadJSONPref(p, prefAcaraKustom, &s.AcaraKustom); err != nil {
+		fmt.Println("Acara kustom rusak:", err)
+	}
+	if err := loadJSONPref(p, prefPengingat, &s.Pengingat); err != nil {
+		fmt.Println("Pengaturan pengingat rusak:", err)
+	}
+	return s
+}
+
+func (s appSettings) save(p fyne.Preferences) {
+	p.SetInt(prefKurup, int(s.Kurup))
+	p.SetBool(prefAturanMaghrib, s.AturanMaghrib)
+	p.SetString(prefJamMaghrib, formatJam(s.JamMaghrib))
+	p.SetString(prefPakem, s.PakemID)
+	p.SetBool(prefPendhakJawa, s.PendhakJawa)
+	saveJSONPref(p, prefPakemImpor, s.PakemImpor)
+	saveJSONPref(p, prefAcaraKustom, s.AcaraKustom)
+	saveJSONPref(p, prefPengingat, s.Pengingat)
+}
+
+// loadJSONPref membaca nilai JSON dari Preferences. Kunci kosong bukan error.
+func loadJSONPref(p fyne.Preferences, key string, v any) error {
+	raw := p.String(key)
+	if raw == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(raw), v)
+}
+
+func saveJSONPref(p fyne.Preferences, key string, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		fmt.Println("Gagal simpan", key+":", err)
+		return
+	}
+	p.SetString(key, string(data))
+}
+
+// daftarPakem berisi pakem bawaan diikuti pakem impor. Pakem impor yang ID-nya
+// sama dengan pakem bawaan menggantikannya.
+func (s appSettings) daftarPakem() []selamatan.Pakem {
+	hasil := selamatan.PakemBawaan()
+	for _, imp := range s.PakemImpor {
+		diganti := false
+		for i := range hasil {
+			if hasil[i].ID == imp.ID {
+				hasil[i] = imp
+				diganti = true
+			}
+		}
+		if !diganti {
+			hasil = append(hasil, imp)
+		}
+	}
+	return hasil
+}
+
+// pakem mengembalikan pakem yang dipilih, atau pakem standar bila tidak ada.
+func (s appSettings) pakem() selamatan.Pakem {
+	daftar := s.daftarPakem()
+	for _, p := range daftar {
+		if p.ID == s.PakemID {
+			return p
+		}
+	}
+	return daftar[0]
+}
+
+// hitungJadwal menyusun jadwal selamatan dari hari geblag menurut pengaturan.
+func (s appSettings) hitungJadwal(geblag time.Time) []selamatan.Jadwal {
+	opsi := selamatan.Opsi{Kurup: s.Kurup, PendhakJawa: s.PendhakJawa}
+	return s.pakem().Hitung(geblag, opsi, s.AcaraKustom...)
+}
+
+// hariJawa mengembalikan tanggal (jam dinolkan) yang dipakai untuk hitungan
+// Jawa, menerapkan aturan maghrib bila diaktifkan.
+func (s appSettings) hariJawa(t time.Time) (time.Time, bool) {
+	if !s.AturanMaghrib {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()), false
+	}
+	return jawa.GeserMaghrib(t, s.JamMaghrib)
+}
+
+func showSettingsPopup(win fyne.Window, prefs fyne.Preferences, settings *appSettings, onChanged func()) {
+	parentCanvas := win.Canvas()
+
+	lblKurup := canvas.NewText("Kurup Penanggalan Jawa:", ColorTextGrey)
+	lblKurup.TextSize = 12
+	selKurup := widget.NewSelect(jawa.NamaKurup, nil)
+	selKurup.SetSelectedIndex(int(settings.Kurup))
+	noteKurup := widget.NewLabel("Aboge: 1 Suro Alip jatuh Rebo Wage. Asapon: Selasa Pon (kalender resmi saat ini). Anenge: Senen Pahing. Pilih sesuai kebiasaan di daerah Anda.")
+	noteKurup.Wrapping = fyne.TextWrapWord
+	noteKurup.TextStyle = fyne.TextStyle{Italic: true}
+
+	chkMaghrib := widget.NewCheck("Hari Jawa berganti saat maghrib", nil)
+	chkMaghrib.SetChecked(settings.AturanMaghrib)
+	lblJamMaghrib := canvas.NewText("Jam Maghrib:", ColorTextGrey)
+	lblJamMaghrib.TextSize = 12
+	entryMaghrib := widget.NewEntry()
+	entryMaghrib.SetText(formatJam(settings.JamMaghrib))
+	noteMaghrib := widget.NewLabel("Bila jam wafat/lahir diisi dan sudah lewat maghrib, weton dan jadwal dihitung dari hari berikutnya.")
+	noteMaghrib.Wrapping = fyne.TextWrapWord
+	noteMaghrib.TextStyle = fyne.TextStyle{Italic: true}
+
+	lblPakem := canvas.NewText("Pakem Jadwal Selamatan:", ColorTextGrey)
+	lblPakem.TextSize = 12
+	notePakem := widget.NewLabel("")
+	notePakem.Wrapping = fyne.TextWrapWord
+	notePakem.TextStyle = fyne.TextStyle{Italic: true}
+	selPakem := widget.NewSelect(nil, nil)
+	var pakemIDs []string
+	refreshPakem := func(selectedID string) {
+		pakemIDs = nil
+		var names []string
+		for _, p := range settings.daftarPakem() {
+			pakemIDs = append(pakemIDs, p.ID)
+			names = append(names, p.Nama)
+		}
+		selPakem.OnChanged = nil
+		selPakem.Options = names
+		selPakem.SetSelectedIndex(0)
+		for i, id := range pakemIDs {
+			if id == selectedID {
+				selPakem.SetSelectedIndex(i)
+			}
+		}
+		notePakem.SetText(settings.daftarPakem()[selPakem.SelectedIndex()].Keterangan)
+		selPakem.OnChanged = func(string) {
+			notePakem.SetText(settings.daftarPakem()[selPakem.SelectedIndex()].Keterangan)
+		}
+	}
+	refreshPakem(settings.pakem().ID)
+
+	chkPendhak := widget.NewCheck("Pengingat pendhak memakai tanggal Jawa", nil)
+	chkPendhak.SetChecked(settings.PendhakJawa)
+	notePendhak := widget.NewLabel("Pendhak dihitung dua cara: selisih hari tetap (353/707 hari) dan tanggal-bulan Jawa yang sama satu/dua tahun Jawa kemudian. Keduanya ditampilkan, pilihan ini menentukan mana yang dipakai untuk status dan pengingat.")
+	notePendhak.Wrapping = fyne.TextWrapWord
+	notePendhak.TextStyle = fyne.TextStyle{Italic: true}
+
+	lblPengingat := canvas.NewText("Pengingat Selamatan:", ColorTextGrey)
+	lblPengingat.TextSize = 12
+	var chkPengingat []*widget.Check
+	for _, h := range PilihanPengingat {
+		teks := fmt.Sprintf("H-%d", h)
+		if h == 0 {
+			teks = "Pagi hari H"
+		}
+		chk := widget.NewCheck(teks, nil)
+		for _, aktif := range settings.Pengingat {
+			if aktif == h {
+				chk.SetChecked(true)
+			}
+		}
+		chkPengingat = append(chkPengingat, chk)
+	}
+	gridPengingat := container.NewGridWithColumns(2)
+	for _, chk := range chkPengingat {
+		gridPengingat.Add(chk)
+	}
+	notePengingat := widget.NewLabel("Notifikasi dikirim untuk setiap profil tersimpan, mulai jam 06.00 pada hari yang dipilih.")
+	notePengingat.Wrapping = fyne.TextWrapWord
+	notePengingat.TextStyle = fyne.TextStyle{Italic: true}
+
+	btnImpor := widget.NewButtonWithIcon("Impor Pakem", theme.FolderOpenIcon(), func() {
+		dialog.ShowFileOpen(func(r fyne.URIReadCloser, err error) {
+			if err != nil || r == nil {
+				return
+			}
+			defer r.Close()
+			data, err := io.ReadAll(r)
+			if err == nil {
+				var p selamatan.Pakem
+				if p, err = selamatan.ParsePakem(data); err == nil {
+					settings.PakemImpor = append(settings.PakemImpor, p)
+					settings.save(prefs)
+					refreshPakem(p.ID)
+					return
+				}
+			}
+			dialog.ShowError(err, win)
+		}, win)
+	})
+	btnImpor.Importance = widget.LowImportance
+
+	btnImporBacaan := widget.NewButtonWithIcon("Impor Bacaan", theme.FolderOpenIcon(), func() {
+		dialog.ShowFileOpen(func(r fyne.URIReadCloser, err error) {
+			if err != nil || r == nil {
+				return
+			}
+			defer r.Close()
+			data, err := io.ReadAll(r)
+			if err == nil {
+				var b bacaan.Bacaan
+				if b, err = bacaan.Parse(data); err == nil {
+					imporBacaan(prefs, b)
+					dialog.ShowInformation("Impor Bacaan", fmt.Sprintf("%s (%d bagian) ditambahkan ke pembaca tahlil.", b.Judul, len(b.Bagian)), win)
+					return
+				}
+			}
+			dialog.ShowError(err, win)
+		}, win)
+	})
+	btnImporBacaan.Importance = widget.LowImportance
+	noteBacaan := widget.NewLabel("Tahlil, Surah Yasin dan doa arwah sudah tersedia. Bacaan tambahan, misalnya doa menurut kebiasaan setempat, bisa diimpor dari berkas JSON berisi judul dan bagian (arab, latin, arti).")
+	noteBacaan.Wrapping = fyne.TextWrapWord
+	noteBacaan.TextStyle = fyne.TextStyle{Italic: true}
+
+	btnKonten, btnKontenBawaan, noteKonten := createKontenButtons(win, prefs, onChanged)
+
+	btnKustom := widget.NewButtonWithIcon(fmt.Sprintf("Acara Kustom (%d)", len(settings.AcaraKustom)), theme.ContentAddIcon(), nil)
+	btnKustom.OnTapped = func() {
+		showAcaraKustomPopup(parentCanvas, prefs, settings, func() {
+			btnKustom.SetText(fmt.Sprintf("Acara Kustom (%d)", len(settings.AcaraKustom)))
+			if onChanged != nil {
+				onChanged()
+			}
+		})
+	}
+	btnKustom.Importance = widget.LowImportance
+
+	form := container.NewVBox(
+		lblKurup,
+		selKurup,
+		noteKurup,
+		widget.NewSeparator(),
+		chkMaghrib,
+		container.NewBorder(nil, nil, lblJamMaghrib, nil, entryMaghrib),
+		noteMaghrib,
+		widget.NewSeparator(),
+		lblPakem,
+		selPakem,
+		notePakem,
+		container.NewGridWithColumns(2, btnImpor, btnKustom),
+		widget.NewSeparator(),
+		chkPendhak,
+		notePendhak,
+		widget.NewSeparator(),
+		lblPengingat,
+		gridPengingat,
+		notePengingat,
+		widget.NewSeparator(),
+		btnImporBacaan,
+		noteBacaan,
+		widget.NewSeparator(),
+		container.NewGridWithColumns(2, btnKonten, btnKontenBawaan),
+		noteKonten,
+	)
+
+	var popup *widget.PopUp
+	btnSimpan := widget.NewButton("Simpan", func() {
+		jam, err := parseJam(entryMaghrib.Text)
+		if err != nil || jam == 0 {
+			entryMaghrib.SetText(formatJam(settings.JamMaghrib))
+			return
+		}
+		settings.Kurup = jawa.Kurup(selKurup.SelectedIndex())
+		settings.AturanMaghrib = chkMaghrib.Checked
+		settings.JamMaghrib = jam
+		settings.PakemID = pakemIDs[selPakem.SelectedIndex()]
+		settings.PendhakJawa = chkPendhak.Checked
+		settings.Pengingat = []int{}
+		for i, chk := range chkPengingat {
+			if chk.Checked {
+				settings.Pengingat = append(settings.Pengingat, PilihanPengingat[i])
+			}
+		}
+		settings.save(prefs)
+		popup.Hide()
+		if onChanged != nil {
+			onChanged()
+		}
+	})
+	btnSimpan.Importance = widget.HighImportance
+
+	popup = showModalCard(parentCanvas, "Pengaturan", form, btnSimpan)
+}
+
+// showAcaraKustomPopup menampilkan daftar acara kustom beserta form untuk
+// menambah acara baru. Perubahan langsung disimpan.
+func showAcaraKustomPopup(parentCanvas fyne.Canvas, prefs fyne.Preferences, settings *appSettings, onChanged func()) {
+	list := container.NewVBox()
+	var refreshList func()
+	refreshList = func() {
+		list.Objects = nil
+		if len(settings.AcaraKustom) == 0 {
+			lblEmpty := canvas.NewText("Belum ada acara kustom.", ColorTextGrey)
+			lblEmpty.TextSize = 12
+			list.Add(lblEmpty)
+		}
+		for i, a := range settings.AcaraKustom {
+			idx := i
+			lbl := canvas.NewText(fmt.Sprintf("%s (hari ke-%d)", a.Nama, a.Hari+1), ColorTextWhite)
+			lbl.TextSize = 12
+			btnDel := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				settings.AcaraKustom = append(settings.AcaraKustom[:idx], settings.AcaraKustom[idx+1:]...)
+				settings.save(prefs)
+				refreshList()
+				if onChanged != nil {
+					onChanged()
+				}
+			})
+			btnDel.Importance = widget.LowImportance
+			list.Add(container.NewBorder(nil, nil, nil, btnDel, lbl))
+		}
+		list.Refresh()
+	}
+	refreshList()
+
+	entryNama := widget.NewEntry()
+	entryNama.SetPlaceHolder("Nama acara, mis. Mendhak Telu")
+	entrySub := widget.NewEntry()
+	entrySub.SetPlaceHolder("Label, mis. 3 Tahun (opsional)")
+	entryHari := widget.NewEntry()
+	entryHari.SetPlaceHolder("Hari ke- (geblag = 1)")
+	entryDesc := widget.NewMultiLineEntry()
+	entryDesc.SetPlaceHolder("Keterangan (opsional)")
+	entryDesc.Wrapping = fyne.TextWrapWord
+	lblError := canvas.NewText("", ColorBadgeRed)
+	lblError.TextSize = 11
+
+	btnTambah := widget.NewButtonWithIcon("Tambah", theme.ContentAddIcon(), func() {
+		var hariKe int
+		_, err := fmt.Sscanf(strings.TrimSpace(entryHari.Text), "%d", &hariKe)
+		nama := strings.TrimSpace(entryNama.Text)
+		if nama == "" || err != nil || hariKe < 1 {
+			lblError.Text = "Isi nama dan hari ke- (angka mulai 1)."
+			lblError.Refresh()
+			return
+		}
+		sub := strings.TrimSpace(entrySub.Text)
+		if sub == "" {
+			sub = fmt.Sprintf("%d Hari", hariKe)
+		}
+		settings.AcaraKustom = append(settings.AcaraKustom, selamatan.Acara{
+			Nama:      nama,
+			Sub:       sub,
+			Hari:      hariKe - 1,
+			Deskripsi: strings.TrimSpace(entryDesc.Text),
+		})
+		settings.save(prefs)
+		entryNama.SetText("")
+		entrySub.SetText("")
+		entryHari.SetText("")
+		entryDesc.SetText("")
+		lblError.Text = ""
+		lblError.Refresh()
+		refreshList()
+		if onChanged != nil {
+			onChanged()
+		}
+	})
+	btnTambah.Importance = widget.HighImportance
+
+	body := container.NewVBox(
+		list,
+		widget.NewSeparator(),
+		entryNama,
+		entrySub,
+		entryHari,
+		entryDesc,
+		lblError,
+		container.NewCenter(btnTambah),
+	)
+	showModalCard(parentCanvas, "Acara Kustom", body)
+}
+
+// showModalCard menampilkan popup modal bergaya kartu: judul di atas, isi
+// yang bisa di-scroll, tombol "Tutup" dan tombol tambahan di bawah.
+func showModalCard(parentCanvas fyne.Canvas, title string, body fyne.CanvasObject, actions ...*widget.Button) *widget.PopUp {
+	lblHeader := widget.NewLabel(title)
+	lblHeader.Alignment = fyne.TextAlignCenter
+	lblHeader.TextStyle = fyne.TextStyle{Bold: true}
+
+	var popup *widget.PopUp
+	btnClose := widget.NewButton("Tutup", func() {
+		if popup != nil {
+			popup.Hide()
+		}
+	})
+
+	buttonRow := container.NewHBox(btnClose, layout.NewSpacer())
+	for _, btn := range actions {
+		buttonRow.Add(btn)
+	}
+
+	scrollContainer := container.NewVScroll(container.NewPadded(body))
+	scrollContainer.SetMinSize(fyne.NewSize(0, 300))
+
+	contentBox := container.NewBorder(
+		lblHeader,
+		container.NewPadded(buttonRow),
+		nil, nil,
+		scrollContainer,
+	)
+
+	bgRect := canvas.NewRectangle(ColorCardBg)
+	bgRect.CornerRadius = 12
+	bgRect.SetMinSize(fyne.NewSize(300, 400))
+
+	finalPopupContent := container.NewStack(bgRect, container.NewPadded(contentBox))
+
+	popup = widget.NewModalPopUp(container.NewCenter(finalPopupContent), parentCanvas)
+	popup.Resize(fyne.NewSize(320, 450))
+	popup.Show()
+	return popup
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"fyne.io/fyne/v2/test"
+)
+
+func TestLoadSettingsPengingat(t *testing.T) {
+	asli := append([]int(nil), PilihanPengingat...)
+
+	tests := []struct {
+		tersimpan string
+		want      []int
+	}{
+		{"", PilihanPengingat},
+		{"[3,1]", []int{3, 1}},
+		{"[0]", []int{0}},
+		{"[]", []int{}},
+	}
+	for _, tt := range tests {
+		prefs := test.NewApp().Preferences()
+		if tt.tersimpan != "" {
+			prefs.SetString(prefPengingat, tt.tersimpan)
+		}
+		s := loadSettings(prefs)
+		if !reflect.DeepEqual(s.Pengingat, tt.want) {
+			t.Errorf("tersimpan %q: Pengingat = %v, want %v", tt.tersimpan, s.Pengingat, tt.want)
+		}
+		if !reflect.DeepEqual(PilihanPengingat, asli) {
+			t.Fatalf("tersimpan %q mengubah PilihanPengingat menjadi %v", tt.tersimpan, PilihanPengingat)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+)
+
+// ==========================================
+// PENGINGAT SELAMATAN
+// ==========================================
+
+const prefPengingatTerkirim = "pengingat_terkirim" // JSON []string, kunci pengingatKey
+
+// PilihanPengingat adalah jarak hari pengingat yang bisa dipilih di
+// pengaturan. 0 berarti pagi hari acara.
+var PilihanPengingat = []int{7, 3, 1, 0}
+
+// jamPengingat adalah jam pengingat dikirim pada hari H-n.
+const jamPengingat = 6 * time.Hour
+
+// intervalPengingat adalah selang pemeriksaan pengingat selama aplikasi hidup.
+const intervalPengingat = 15 * time.Minute
+
+// pengingat adalah satu notifikasi yang jatuh tempo.
+type pengingat struct {
+	Profil  profil
+	Acara   string
+	Tanggal time.Time
+	Sisa    int      // hari menuju acara saat pengingat dikirim
+	Kunci   []string // semua kunci yang ditandai terkirim oleh pengingat ini
+}
+
+func formatSisaHari(n int) string {
+	if n == 0 {
+		return "hari ini"
+	}
+	return fmt.Sprintf("%d hari lagi", n)
+}
+
+func (p pengingat) notifikasi() *fyne.Notification {
+	return fyne.NewNotification(
+		fmt.Sprintf("%s %s", p.Acara, p.Profil),
+		fmt.Sprintf("Selamatan %s, %s (%s).", formatSisaHari(p.Sisa), formatIndoDate(p.Tanggal), formatWeton(p.Tanggal, p.Profil.Kurup)),
+	)
+}
+
+// pengingatKey menandai satu pengingat secara unik: profil, acara, tanggal
+// acara dan jarak harinya. Bila tanggal acara berubah (profil diubah atau
+// pengaturan berganti), kuncinya ikut berubah.
+func pengingatKey(pr profil, acara string, tanggal time.Time, h int) string {
+	return fmt.Sprintf("%s|%s|%s|%d", pr.ID, acara, tanggal.Format("2006-01-02"), h)
+}
+
+// pengingatJatuhTempo menghitung ulang seluruh jadwal dari profil tersimpan
+// dan mengembalikan pengingat yang waktunya sudah lewat tetapi belum terkirim.
+// Bila beberapa jarak hari sekaligus jatuh tempo (misalnya aplikasi lama tidak
+// dibuka), hanya yang terdekat yang dikirim.
+func pengingatJatuhTempo(daftar []profil, s appSettings, now time.Time, terkirim map[string]bool) []pengingat {
+	jarak := append([]int{}, s.Pengingat...)
+	sort.Sort(sort.Reverse(sort.IntSlice(jarak)))
+
+	var hasil []pengingat
+	for _, pr := range daftar {
+		for _, j := range pr.jadwal(s) {
+			if !now.Before(j.Tanggal.AddDate(0, 0, 1)) {
+				continue
+			}
+			var p *pengingat
+			for _, h := range jarak {
+				key := pengingatKey(pr, j.Acara.Nama, j.Tanggal, h)
+				if terkirim[key] || now.Before(j.Tanggal.AddDate(0, 0, -h).Add(jamPengingat)) {
+					continue
+				}
+				if p == nil {
+					sisa := jawa.DateToJDN(j.Tanggal) - jawa.DateToJDN(now)
+					p = &pengingat{Profil: pr, Acara: j.Acara.Nama, Tanggal: j.Tanggal, Sisa: sisa}
+				}
+				p.Kunci = append(p.Kunci, key)
+			}
+			if p != nil {
+				hasil = append(hasil, *p)
+			}
+		}
+	}
+	return hasil
+}
+
+// cekPengingat mengirim pengingat yang jatuh tempo dan mencatatnya agar tidak
+// terkirim dua kali. Kunci untuk acara yang sudah lewat dibuang.
+func cekPengingat(a fyne.App, s appSettings) {
+	prefs := a.Preferences()
+	var daftarKunci []string
+	if err := loadJSONPref(prefs, prefPengingatTerkirim, &daftarKunci); err != nil {
+		fmt.Println("Catatan pengingat rusak:", err)
+	}
+	terkirim := make(map[string]bool, len(daftarKunci))
+	for _, k := range daftarKunci {
+		terkirim[k] = true
+	}
+
+	now := time.Now()
+	baru := pengingatJatuhTempo(loadProfil(prefs), s, now, terkirim)
+	for _, p := range baru {
+		a.SendNotification(p.notifikasi())
+		for _, k := range p.Kunci {
+			terkirim[k] = true
+		}
+	}
+
+	kemarin := now.AddDate(0, 0, -1).Format("2006-01-02")
+	simpan := []string{}
+	for k := range terkirim {
+		if bagian := strings.Split(k, "|"); len(bagian) == 4 && bagian[2] < kemarin {
+			continue
+		}
+		simpan = append(simpan, k)
+	}
+	if len(baru) > 0 || len(simpan) != len(daftarKunci) {
+		sort.Strings(simpan)
+		saveJSONPref(prefs, prefPengingatTerkirim, simpan)
+	}
+}
+
+// mulaiPengingat memeriksa pengingat saat aplikasi dibuka lalu secara berkala.
+// Pengaturan dibaca di thread utama karena bisa diubah dari UI.
+func mulaiPengingat(a fyne.App, settings *appSettings) {
+	go func() {
+		for {
+			fyne.Do(func() { cekPengingat(a, *settings) })
+			time.Sleep(intervalPengingat)
+		}
+	}()
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"fyne.io/fyne/v2/test"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+)
+
+func TestPengingatJatuhTempo(t *testing.T) {
+	jam := func(y int, m time.Month, d, h, min int) time.Time {
+		return time.Date(y, m, d, h, min, 0, 0, time.UTC)
+	}
+	pr := profil{ID: "p1", Sebutan: "Almarhum", Nama: "Sastro", Geblag: jam(2024, 3, 1, 0, 0), Kurup: jawa.Asapon}
+	s := appSettings{Kurup: jawa.Asapon, Pengingat: []int{0, 7, 1}}
+	mitung := jam(2024, 3, 7, 0, 0) // geblag + 6 hari
+	kunci := func(h int) string { return pengingatKey(pr, "Mitung", mitung, h) }
+
+	tests := []struct {
+		nama     string
+		now      time.Time
+		terkirim []int // jarak hari yang sudah terkirim
+		sisa     int   // -1 berarti tidak ada pengingat Mitung
+		kunci    []int
+	}{
+		{"sebelum jam H-7", jam(2024, 2, 29, 5, 59), nil, -1, nil},
+		{"H-7", jam(2024, 2, 29, 6, 0), nil, 7, []int{7}},
+		{"H-7 sudah terkirim", jam(2024, 3, 2, 12, 0), []int{7}, -1, nil},
+		{"sebelum jam H-1", jam(2024, 3, 6, 5, 59), []int{7}, -1, nil},
+		{"H-1", jam(2024, 3, 6, 6, 0), []int{7}, 1, []int{1}},
+		{"H-7 dan H-1 terlewat, dikirim sekali", jam(2024, 3, 6, 6, 0), nil, 1, []int{7, 1}},
+		{"H-0", jam(2024, 3, 7, 6, 0), []int{7, 1}, 0, []int{0}},
+		{"H-0 malam hari", jam(2024, 3, 7, 23, 59), []int{7, 1}, 0, []int{0}},
+		{"H-0 sudah terkirim", jam(2024, 3, 7, 12, 0), []int{7, 1, 0}, -1, nil},
+		{"acara lewat", jam(2024, 3, 8, 6, 0), nil, -1, nil},
+	}
+	for _, tt := range tests {
+		terkirim := map[string]bool{}
+		for _, h := range tt.terkirim {
+			terkirim[kunci(h)] = true
+		}
+		var got *pengingat
+		for _, p := range pengingatJatuhTempo([]profil{pr}, s, tt.now, terkirim) {
+			if p.Acara == "Mitung" {
+				if got != nil {
+					t.Errorf("%s: Mitung muncul dua kali", tt.nama)
+				}
+				p := p
+				got = &p
+			}
+		}
+		if tt.sisa < 0 {
+			if got != nil {
+				t.Errorf("%s: pengingat %+v, want tidak ada", tt.nama, *got)
+			}
+			continue
+		}
+		if got == nil {
+			t.Errorf("%s: tidak ada pengingat, want sisa %d", tt.nama, tt.sisa)
+			continue
+		}
+		var want []string
+		for _, h := range tt.kunci {
+			want = append(want, kunci(h))
+		}
+		if got.Sisa != tt.sisa || !got.Tanggal.Equal(mitung) || !reflect.DeepEqual(got.Kunci, want) {
+			t.Errorf("%s: sisa %d tanggal %s kunci %v, want sisa %d tanggal %s kunci %v",
+				tt.nama, got.Sisa, got.Tanggal.Format("2006-01-02"), got.Kunci, tt.sisa, mitung.Format("2006-01-02"), want)
+		}
+	}
+}
+
+func TestCekPengingatSekali(t *testing.T) {
+	a := test.NewApp()
+	prefs := a.Preferences()
+
+	// Mitung tiga hari lagi, jadi H-7 sudah jatuh tempo dan acara lain belum
+	// atau sudah lewat.
+	now := time.Now()
+	geblag := time.Date(now.Year(), now.Month(), now.Day()-3, 0, 0, 0, 0, now.Location())
+	pr := profil{ID: "p1", Sebutan: "Almarhum", Nama: "Sastro", Geblag: geblag, Kurup: jawa.Asapon}
+	saveProfil(prefs, []profil{pr})
+	s := appSettings{Kurup: jawa.Asapon, Pengingat: []int{7}}
+
+	mitung := geblag.AddDate(0, 0, 6)
+	want := pengingat{Profil: pr, Acara: "Mitung", Tanggal: mitung, Sisa: 3}.notifikasi()
+	test.AssertNotificationSent(t, want, func() { cekPengingat(a, s) })
+
+	var tersimpan []string
+	if err := loadJSONPref(prefs, prefPengingatTerkirim, &tersimpan); err != nil {
+		t.Fatal(err)
+	}
+	if k := pengingatKey(pr, "Mitung", mitung, 7); !reflect.DeepEqual(tersimpan, []string{k}) {
+		t.Errorf("kunci tersimpan %v, want [%s]", tersimpan, k)
+	}
+
+	// Pemeriksaan berikutnya tidak boleh mengirim ulang.
+	test.AssertNotificationSent(t, nil, func() { cekPengingat(a, s) })
+}
@@ -0,0 +1,239 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// PROFIL ALMARHUM / ALMARHUMAH
+// ==========================================
+
+const prefProfil = "profil" // JSON []profil
+
+var NamaSebutan = []string{"Almarhum", "Almarhumah"}
+
+// profil adalah data satu kerabat yang sudah wafat. Jam wafat bersifat
+// opsional: bila tidak diisi, Geblag berjam 00.00.
+type profil struct {
+	ID      string     `json:"id"`
+	Sebutan string     `json:"sebutan"`
+	Nama    string     `json:"nama"`
+	Geblag  time.Time  `json:"geblag"`
+	Kurup   jawa.Kurup `json:"kurup"`
+	Catatan string     `json:"catatan,omitempty"`
+}
+
+func (p profil) String() string {
+	return p.Sebutan + " " + p.Nama
+}
+
+// pengaturan mengembalikan salinan s dengan kurup milik profil.
+func (p profil) pengaturan(s appSettings) appSettings {
+	s.Kurup = p.Kurup
+	return s
+}
+
+// jadwal menghitung jadwal selamatan profil menurut pengaturan s, dengan
+// kurup milik profil dan aturan maghrib yang berlaku.
+func (p profil) jadwal(s appSettings) []selamatan.Jadwal {
+	ps := p.pengaturan(s)
+	geblag, _ := ps.hariJawa(p.Geblag)
+	return ps.hitungJadwal(geblag)
+}
+
+func loadProfil(p fyne.Preferences) []profil {
+	var daftar []profil
+	if err := loadJSONPref(p, prefProfil, &daftar); err != nil {
+		fmt.Println("Profil rusak:", err)
+	}
+	for i := range daftar {
+		daftar[i].Geblag = daftar[i].Geblag.Local()
+	}
+	return daftar
+}
+
+func saveProfil(p fyne.Preferences, daftar []profil) {
+	saveJSONPref(p, prefProfil, daftar)
+}
+
+// showProfilPopup menampilkan daftar profil tersimpan. Profil yang dipilih
+// diteruskan ke onOpen. Tambah, ubah dan hapus langsung disimpan.
+func showProfilPopup(win fyne.Window, prefs fyne.Preferences, settings appSettings, onOpen func(profil)) {
+	parentCanvas := win.Canvas()
+	list := container.NewVBox()
+	var popup *widget.PopUp
+
+	var refreshList func()
+	refreshList = func() {
+		daftar := loadProfil(prefs)
+		list.Objects = nil
+		if len(daftar) == 0 {
+			lblEmpty := canvas.NewText("Belum ada profil tersimpan.", ColorTextGrey)
+			lblEmpty.TextSize = 12
+			list.Add(lblEmpty)
+		}
+		for _, pr := range daftar {
+			pr := pr
+			btnOpen := widget.NewButton(pr.String(), func() {
+				popup.Hide()
+				onOpen(pr)
+			})
+			btnOpen.Alignment = widget.ButtonAlignLeading
+			btnOpen.Importance = widget.LowImportance
+			lblTgl := canvas.NewText(formatIndoDateTime(pr.Geblag)+" · "+pr.Kurup.String(), ColorTextGrey)
+			lblTgl.TextSize = 11
+
+			btnEdit := widget.NewButtonWithIcon("", theme.DocumentCreateIcon(), func() {
+				showProfilForm(parentCanvas, prefs, pr, refreshList)
+			})
+			btnEdit.Importance = widget.LowImportance
+			btnDel := widget.NewButtonWithIcon("", theme.DeleteIcon(), func() {
+				dialog.ShowConfirm("Hapus Profil", "Hapus profil "+pr.String()+"?", func(ok bool) {
+					if !ok {
+						return
+					}
+					hapusProfil(prefs, pr.ID)
+					refreshList()
+				}, win)
+			})
+			btnDel.Importance = widget.LowImportance
+
+			list.Add(container.NewBorder(nil, nil, nil, container.NewHBox(btnEdit, btnDel),
+				container.NewVBox(btnOpen, container.NewPadded(lblTgl))))
+			list.Add(widget.NewSeparator())
+		}
+		list.Refresh()
+	}
+	refreshList()
+
+	btnTambah := widget.NewButtonWithIcon("Tambah", theme.ContentAddIcon(), func() {
+		baru := profil{Sebutan: NamaSebutan[0], Geblag: time.Now(), Kurup: settings.Kurup}
+		baru.Geblag = time.Date(baru.Geblag.Year(), baru.Geblag.Month(), baru.Geblag.Day(), 0, 0, 0, 0, baru.Geblag.Location())
+		showProfilForm(parentCanvas, prefs, baru, refreshList)
+	})
+	btnTambah.Importance = widget.HighImportance
+
+	popup = showModalCard(parentCanvas, "Profil Almarhum/Almarhumah", list, btnTambah)
+}
+
+// simpanProfil menambah pr ke daftar, atau menggantikan profil dengan ID sama.
+func simpanProfil(prefs fyne.Preferences, pr profil) {
+	daftar := loadProfil(prefs)
+	for i := range daftar {
+		if daftar[i].ID == pr.ID {
+			daftar[i] = pr
+			saveProfil(prefs, daftar)
+			return
+		}
+	}
+	saveProfil(prefs, append(daftar, pr))
+}
+
+func hapusProfil(prefs fyne.Preferences, id string) {
+	daftar := loadProfil(prefs)
+	for i := range daftar {
+		if daftar[i].ID == id {
+			saveProfil(prefs, append(daftar[:i], daftar[i+1:]...))
+			hapusChecklistProfil(prefs, id)
+			saveBiaya(prefs, profil{ID: id}, nil)
+			return
+		}
+	}
+}
+
+// showProfilForm menampilkan form tambah/ubah profil. Profil dengan ID kosong
+// dianggap baru.
+func showProfilForm(parentCanvas fyne.Canvas, prefs fyne.Preferences, pr profil, onSaved func()) {
+	selSebutan := widget.NewSelect(NamaSebutan, nil)
+	selSebutan.SetSelected(pr.Sebutan)
+	if selSebutan.SelectedIndex() < 0 {
+		selSebutan.SetSelectedIndex(0)
+	}
+	entryNama := widget.NewEntry()
+	entryNama.SetPlaceHolder("Nama")
+	entryNama.SetText(pr.Nama)
+
+	geblag := pr.Geblag
+	lblTanggal := widget.NewLabel(formatIndoDateTime(geblag))
+	lblTanggal.TextStyle = fyne.TextStyle{Bold: true}
+	btnTanggal := widget.NewButtonWithIcon("", theme.CalendarIcon(), nil)
+
+	selKurup := widget.NewSelect(jawa.NamaKurup, nil)
+	selKurup.SetSelectedIndex(int(pr.Kurup))
+	btnTanggal.OnTapped = func() {
+		createCalendarPopup(parentCanvas, geblag, jawa.Kurup(selKurup.SelectedIndex()), nil, func(t time.Time) {
+			geblag = t
+			lblTanggal.SetText(formatIndoDateTime(geblag))
+		})
+	}
+
+	entryCatatan := widget.NewMultiLineEntry()
+	entryCatatan.SetPlaceHolder("Catatan (opsional)")
+	entryCatatan.Wrapping = fyne.TextWrapWord
+	entryCatatan.SetText(pr.Catatan)
+
+	lblError := canvas.NewText("", ColorBadgeRed)
+	lblError.TextSize = 11
+
+	label := func(s string) *canvas.Text {
+		t := canvas.NewText(s, ColorTextGrey)
+		t.TextSize = 12
+		return t
+	}
+	form := container.NewVBox(
+		label("Sebutan & Nama:"),
+		container.NewBorder(nil, nil, selSebutan, nil, entryNama),
+		label("Tanggal Wafat / Geblag:"),
+		container.NewBorder(nil, nil, nil, btnTanggal, lblTanggal),
+		label("Kurup:"),
+		selKurup,
+		label("Catatan:"),
+		entryCatatan,
+		lblError,
+		layout.NewSpacer(),
+	)
+
+	var popup *widget.PopUp
+	btnSimpan := widget.NewButton("Simpan", func() {
+		nama := strings.TrimSpace(entryNama.Text)
+		if nama == "" {
+			lblError.Text = "Nama harus diisi."
+			lblError.Refresh()
+			return
+		}
+		if pr.ID == "" {
+			pr.ID = strconv.FormatInt(time.Now().UnixNano(), 36)
+		}
+		pr.Sebutan = selSebutan.Selected
+		pr.Nama = nama
+		pr.Geblag = geblag
+		pr.Kurup = jawa.Kurup(selKurup.SelectedIndex())
+		pr.Catatan = strings.TrimSpace(entryCatatan.Text)
+		simpanProfil(prefs, pr)
+		popup.Hide()
+		if onSaved != nil {
+			onSaved()
+		}
+	})
+	btnSimpan.Importance = widget.HighImportance
+
+	judul := "Ubah Profil"
+	if pr.ID == "" {
+		judul = "Profil Baru"
+	}
+	popup = showModalCard(parentCanvas, judul, form, btnSimpan)
+}
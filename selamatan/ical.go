@@ -0,0 +1,114 @@
+package selamatan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ==========================================
+// EKSPOR ICALENDAR (RFC 5545)
+// ==========================================
+
+// EventICS adalah satu acara sehari penuh di dalam berkas iCalendar.
+type EventICS struct {
+	UID       string
+	Judul     string
+	Deskripsi string
+	Tanggal   time.Time
+}
+
+// TulisICS menulis kalender RFC 5545 berisi satu VEVENT per event. Setiap
+// event mendapat VALARM untuk tiap nilai alarm, yaitu waktu relatif terhadap
+// awal hari acara (negatif berarti sebelum hari H).
+func TulisICS(w io.Writer, nama string, events []EventICS, alarm []time.Duration) error {
+	bw := bufio.NewWriter(w)
+	baris := func(s string) {
+		bw.WriteString(lipatBaris(s))
+		bw.WriteString("\r\n")
+	}
+
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	baris("BEGIN:VCALENDAR")
+	baris("VERSION:2.0")
+	baris("PRODID:-//kalender-selamatan//Jadwal Selamatan//ID")
+	baris("CALSCALE:GREGORIAN")
+	baris("METHOD:PUBLISH")
+	baris("X-WR-CALNAME:" + escapeICS(nama))
+	for _, e := range events {
+		baris("BEGIN:VEVENT")
+		baris("UID:" + e.UID)
+		baris("DTSTAMP:" + stamp)
+		baris("DTSTART;VALUE=DATE:" + e.Tanggal.Format("20060102"))
+		baris("DTEND;VALUE=DATE:" + e.Tanggal.AddDate(0, 0, 1).Format("20060102"))
+		baris("SUMMARY:" + escapeICS(e.Judul))
+		if e.Deskripsi != "" {
+			baris("DESCRIPTION:" + escapeICS(e.Deskripsi))
+		}
+		baris("TRANSP:TRANSPARENT")
+		for _, a := range alarm {
+			baris("BEGIN:VALARM")
+			baris("ACTION:DISPLAY")
+			baris("DESCRIPTION:" + escapeICS(e.Judul))
+			baris("TRIGGER;RELATED=START:" + durasiICS(a))
+			baris("END:VALARM")
+		}
+		baris("END:VEVENT")
+	}
+	baris("END:VCALENDAR")
+	return bw.Flush()
+}
+
+// escapeICS meloloskan karakter khusus pada nilai TEXT.
+func escapeICS(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// lipatBaris memotong baris lebih dari 75 oktet menjadi beberapa baris
+// lanjutan yang diawali spasi, tanpa memotong karakter UTF-8.
+func lipatBaris(s string) string {
+	var b strings.Builder
+	panjang := 0
+	for _, r := range s {
+		n := utf8.RuneLen(r)
+		if panjang+n > 75 {
+			b.WriteString("\r\n ")
+			panjang = 1
+		}
+		b.WriteRune(r)
+		panjang += n
+	}
+	return b.String()
+}
+
+// durasiICS menulis durasi dalam format DURATION, misalnya -P6DT18H.
+func durasiICS(d time.Duration) string {
+	tanda := ""
+	if d < 0 {
+		tanda = "-"
+		d = -d
+	}
+	hari := d / (24 * time.Hour)
+	d -= hari * 24 * time.Hour
+	jam := d / time.Hour
+	d -= jam * time.Hour
+	menit := d / time.Minute
+
+	s := tanda + "P"
+	if hari > 0 {
+		s += fmt.Sprintf("%dD", hari)
+	}
+	if jam > 0 || menit > 0 || hari == 0 {
+		s += "T"
+		if jam > 0 {
+			s += fmt.Sprintf("%dH", jam)
+		}
+		if menit > 0 || jam == 0 {
+			s += fmt.Sprintf("%dM", menit)
+		}
+	}
+	return s
+}
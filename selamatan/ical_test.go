@@ -0,0 +1,93 @@
+package selamatan
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEscapeICS(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"Mitung", "Mitung"},
+		{"a,b;c", `a\,b\;c`},
+		{`C:\jalan`, `C:\\jalan`},
+		{"baris 1\nbaris 2", `baris 1\nbaris 2`},
+		{"baris 1\r\nbaris 2", `baris 1\nbaris 2`},
+	}
+	for _, tt := range tests {
+		if got := escapeICS(tt.in); got != tt.want {
+			t.Errorf("escapeICS(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLipatBaris(t *testing.T) {
+	tests := []string{
+		"SUMMARY:pendek",
+		"DESCRIPTION:" + strings.Repeat("abcdefghij", 20),
+		"DESCRIPTION:" + strings.Repeat("Nyewu ꦱꦼꦭꦩꦠꦤ꧀ ", 15), // aksara Jawa 3 oktet
+	}
+	for _, in := range tests {
+		out := lipatBaris(in)
+		for _, l := range strings.Split(out, "\r\n") {
+			if len(l) > 75 {
+				t.Errorf("baris %d oktet: %q", len(l), l)
+			}
+			if !utf8.ValidString(l) {
+				t.Errorf("karakter UTF-8 terpotong: %q", l)
+			}
+		}
+		if unfold := strings.ReplaceAll(out, "\r\n ", ""); unfold != in {
+			t.Errorf("unfold(lipatBaris(%q)) = %q", in, unfold)
+		}
+	}
+}
+
+func TestDurasiICS(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "PT0M"},
+		{6 * time.Hour, "PT6H"},
+		{-7*24*time.Hour + 6*time.Hour, "-P6DT18H"},
+		{-24 * time.Hour, "-P1D"},
+		{-90 * time.Minute, "-PT1H30M"},
+	}
+	for _, tt := range tests {
+		if got := durasiICS(tt.d); got != tt.want {
+			t.Errorf("durasiICS(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestTulisICS(t *testing.T) {
+	var buf bytes.Buffer
+	events := []EventICS{{
+		UID:       "20240101-mitung@kalender-selamatan",
+		Judul:     "Mitung Bapak, Ibu",
+		Deskripsi: "7 Hari\nRabu Legi",
+		Tanggal:   time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC),
+	}}
+	if err := TulisICS(&buf, "Selamatan", events, []time.Duration{-18 * time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.HasSuffix(out, "END:VCALENDAR\r\n") || strings.Contains(strings.ReplaceAll(out, "\r\n", ""), "\n") {
+		t.Errorf("baris harus diakhiri CRLF:\n%q", out)
+	}
+	for _, want := range []string{
+		"BEGIN:VEVENT\r\n",
+		"DTSTART;VALUE=DATE:20240107\r\n",
+		"DTEND;VALUE=DATE:20240108\r\n",
+		`SUMMARY:Mitung Bapak\, Ibu` + "\r\n",
+		`DESCRIPTION:7 Hari\nRabu Legi` + "\r\n",
+		"TRIGGER;RELATED=START:-PT18H\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("keluaran tidak berisi %q", want)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Package selamatan menghitung jadwal selamatan kematian (geblag sampai nyewu
+// dan seterusnya) dari sebuah pakem, yaitu daftar acara beserta jaraknya dari
+// hari geblag. Pakem bisa berasal dari berkas JSON bawaan maupun buatan
+// pengguna, karena tiap daerah punya tatacara sendiri.
+package selamatan
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+)
+
+// ==========================================
+// DEFINISI PAKEM
+// ==========================================
+
+// Acara adalah satu upacara selamatan di dalam pakem.
+type Acara struct {
+	Nama string `json:"nama"`
+	Sub  string `json:"sub"`
+
+	// Hari adalah selisih hari dari geblag (geblag = 0, nelung = 2, dst).
+	Hari int `json:"hari"`
+
+	// TahunJawa, bila lebih dari 0, adalah ulang tahun Jawa acara: tanggal
+	// dan bulan Jawa yang sama dengan geblag, sekian tahun Jawa kemudian.
+	// Bila Hari juga diisi (seperti pendhak), acara punya dua tanggal dan
+	// Opsi.PendhakJawa menentukan mana yang utama. Bila Hari 0, acara hanya
+	// dihitung menurut tahun Jawa.
+	TahunJawa int `json:"tahun_jawa,omitempty"`
+
+	Deskripsi string `json:"deskripsi,omitempty"`
+}
+
+// Pakem adalah profil jadwal: kumpulan acara menurut satu tradisi.
+type Pakem struct {
+	ID         string  `json:"id"`
+	Nama       string  `json:"nama"`
+	Keterangan string  `json:"keterangan,omitempty"`
+	Acara      []Acara `json:"acara"`
+
+	// Haul adalah jumlah peringatan tahunan (menurut tahun Jawa) yang
+	// ditambahkan setelah acara terakhir. 0 berarti tanpa haul.
+	Haul int `json:"haul,omitempty"`
+}
+
+// Validate memeriksa pakem sebelum dipakai atau disimpan.
+func (p Pakem) Validate() error {
+	if p.ID == "" || p.Nama == "" {
+		return fmt.Errorf("pakem harus punya id dan nama")
+	}
+	if len(p.Acara) == 0 {
+		return fmt.Errorf("pakem %q tidak punya acara", p.ID)
+	}
+	for _, a := range p.Acara {
+		if a.Nama == "" {
+			return fmt.Errorf("pakem %q: ada acara tanpa nama", p.ID)
+		}
+		if a.Hari < 0 || a.TahunJawa < 0 {
+			return fmt.Errorf("pakem %q: acara %q punya jarak negatif", p.ID, a.Nama)
+		}
+	}
+	if p.Haul < 0 {
+		return fmt.Errorf("pakem %q: jumlah haul negatif", p.ID)
+	}
+	return nil
+}
+
+// ParsePakem membaca pakem dari JSON dan memvalidasinya.
+func ParsePakem(data []byte) (Pakem, error) {
+	var p Pakem
+	if err := json.Unmarshal(data, &p); err != nil {
+		return Pakem{}, fmt.Errorf("pakem tidak bisa dibaca: %w", err)
+	}
+	if err := p.Validate(); err != nil {
+		return Pakem{}, err
+	}
+	return p, nil
+}
+
+//go:embed pakem/*.json
+var pakemFS embed.FS
+
+// PakemBawaan mengembalikan pakem yang disertakan di dalam aplikasi, urut
+// menurut nama berkas. Pakem pertama adalah pakem standar.
+func PakemBawaan() []Pakem {
+	entries, err := pakemFS.ReadDir("pakem")
+	if err != nil {
+		panic(err)
+	}
+	var hasil []Pakem
+	for _, e := range entries {
+		data, err := pakemFS.ReadFile(path.Join("pakem", e.Name()))
+		if err != nil {
+			panic(err)
+		}
+		p, err := ParsePakem(data)
+		if err != nil {
+			panic(fmt.Sprintf("pakem bawaan %s rusak: %v", e.Name(), err))
+		}
+		hasil = append(hasil, p)
+	}
+	return hasil
+}
+
+// ==========================================
+// PERHITUNGAN JADWAL
+// ==========================================
+
+// Opsi mengatur cara Hitung menyusun jadwal.
+type Opsi struct {
+	Kurup jawa.Kurup
+
+	// PendhakJawa membuat ulang tahun Jawa menjadi tanggal utama bagi acara
+	// yang punya selisih hari sekaligus tahun Jawa. Tanggal utama dipakai
+	// untuk status dan pengingat.
+	PendhakJawa bool
+}
+
+// Jadwal adalah satu acara yang sudah dihitung tanggalnya.
+type Jadwal struct {
+	Acara   Acara
+	Tanggal time.Time // tanggal utama
+
+	// TanggalHari dan TanggalJawa adalah tanggal menurut selisih hari dan
+	// menurut ulang tahun Jawa. Yang tidak berlaku bernilai zero.
+	TanggalHari time.Time
+	TanggalJawa time.Time
+
+	// Rumus dihitung dari selisih tanggal utama dengan geblag.
+	Rumus Rumus
+}
+
+// PunyaDuaTanggal melaporkan apakah acara dihitung dengan selisih hari dan
+// ulang tahun Jawa sekaligus.
+func (j Jadwal) PunyaDuaTanggal() bool {
+	return !j.TanggalHari.IsZero() && !j.TanggalJawa.IsZero()
+}
+
+// Hitung menyusun jadwal dari hari geblag (jam dinolkan, sudah memperhitungkan
+// aturan maghrib bila perlu). Acara tambahan, misalnya buatan pengguna, ikut
+// dihitung. Hasil diurutkan menurut tanggal utama.
+func (p Pakem) Hitung(geblag time.Time, o Opsi, tambahan ...Acara) []Jadwal {
+	geblag = time.Date(geblag.Year(), geblag.Month(), geblag.Day(), 0, 0, 0, 0, geblag.Location())
+
+	semua := append(append([]Acara{}, p.Acara...), tambahan...)
+	var hasil []Jadwal
+	for _, a := range semua {
+		hasil = append(hasil, a.Jadwal(geblag, o))
+	}
+	sort.SliceStable(hasil, func(i, j int) bool {
+		return hasil[i].Tanggal.Before(hasil[j].Tanggal)
+	})
+
+	if p.Haul > 0 && len(hasil) > 0 {
+		terakhir := hasil[len(hasil)-1].Tanggal
+		tahunGeblag := jawa.JavaneseDateOf(geblag, o.Kurup).Tahun
+		tahunMulai := jawa.JavaneseDateOf(terakhir, o.Kurup).Tahun - tahunGeblag + 1
+		for i := 0; i < p.Haul; i++ {
+			n := tahunMulai + i
+			a := Acara{Nama: fmt.Sprintf("Haul %d", n), Sub: fmt.Sprintf("%d Tahun", n), TahunJawa: n}
+			j := a.Jadwal(geblag, o)
+			if !j.Tanggal.After(terakhir) {
+				continue
+			}
+			hasil = append(hasil, j)
+		}
+	}
+	return hasil
+}
+
+// Jadwal menghitung tanggal acara a untuk hari geblag.
+func (a Acara) Jadwal(geblag time.Time, o Opsi) Jadwal {
+	j := Jadwal{Acara: a}
+	if a.TahunJawa == 0 || a.Hari > 0 {
+		j.TanggalHari = geblag.AddDate(0, 0, a.Hari)
+	}
+	if a.TahunJawa > 0 {
+		j.TanggalJawa = UlangTahunJawa(geblag, a.TahunJawa, o.Kurup)
+	}
+
+	switch {
+	case j.TanggalHari.IsZero():
+		j.Tanggal = j.TanggalJawa
+	case j.TanggalJawa.IsZero() || !o.PendhakJawa:
+		j.Tanggal = j.TanggalHari
+	default:
+		j.Tanggal = j.TanggalJawa
+	}
+	j.Rumus = RumusOf(jawa.DateToJDN(j.Tanggal) - jawa.DateToJDN(geblag))
+	return j
+}
+
+// GeblagDari adalah kebalikan Jadwal: mencari hari geblag yang membuat
+// tanggal utama acara a jatuh pada t. Hasilnya bisa lebih dari satu (ulang
+// tahun Jawa yang dibatasi panjang bulan) atau kosong bila t mustahil,
+// misalnya tanggal Jawa yang tidak pernah dicapai.
+func (a Acara) GeblagDari(t time.Time, o Opsi) []time.Time {
+	t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	var calon []time.Time
+	if a.TahunJawa > 0 && (a.Hari == 0 || o.PendhakJawa) {
+		// Mundur sekian tahun Jawa dengan tanggal dan bulan yang sama. Bila t
+		// hari terakhir bulannya, geblag bisa juga tanggal yang lebih besar
+		// yang dipotong UlangTahunJawa (30 Besar tahun wuntu).
+		d := jawa.JavaneseDateOf(t, o.Kurup)
+		d.Tahun -= a.TahunJawa
+		akhir := d.Tanggal
+		if d.Tanggal == jawa.PanjangBulan(d.Tahun+a.TahunJawa, d.Bulan) {
+			akhir = 30
+		}
+		for tgl := d.Tanggal; tgl <= akhir; tgl++ {
+			g, err := jawa.JavaneseToDate(jawa.JavaneseDate{Tanggal: tgl, Bulan: d.Bulan, Tahun: d.Tahun}, o.Kurup, t.Location())
+			if err == nil {
+				calon = append(calon, g)
+			}
+		}
+	} else {
+		calon = append(calon, t.AddDate(0, 0, -a.Hari))
+	}
+
+	var hasil []time.Time
+	for _, g := range calon {
+		if a.Jadwal(g, o).Tanggal.Equal(t) {
+			hasil = append(hasil, g)
+		}
+	}
+	return hasil
+}
+
+// UlangTahunJawa mengembalikan tanggal yang tanggal dan bulan Jawanya sama
+// dengan t, n tahun Jawa kemudian. Bila tanggal itu tidak ada (30 Besar pada
+// tahun yang bukan wuntu), dipakai hari terakhir bulan tersebut.
+func UlangTahunJawa(t time.Time, n int, k jawa.Kurup) time.Time {
+	d := jawa.JavaneseDateOf(t, k)
+	d.Tahun += n
+	if panjang := jawa.PanjangBulan(d.Tahun, d.Bulan); d.Tanggal > panjang {
+		d.Tanggal = panjang
+	}
+	hasil, err := jawa.JavaneseToDate(d, k, t.Location())
+	if err != nil {
+		panic(err) // tidak mungkin: tanggal sudah dibatasi panjang bulan
+	}
+	return hasil
+}
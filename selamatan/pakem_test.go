@@ -0,0 +1,73 @@
+package selamatan
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+)
+
+// Setiap tanggal hasil Hitung harus bisa dibalik ke geblag asalnya, termasuk
+// haul puluhan tahun Jawa kemudian.
+func TestGeblagDariRoundTrip(t *testing.T) {
+	hauls := []Acara{
+		{Nama: "Haul 20", TahunJawa: 20},
+		{Nama: "Haul 50", TahunJawa: 50},
+		{Nama: "Pendhak 20", Hari: 20 * 354, TahunJawa: 20},
+	}
+	for _, p := range PakemBawaan() {
+		for _, k := range jawa.DaftarKurup {
+			for _, pj := range []bool{false, true} {
+				o := Opsi{Kurup: k, PendhakJawa: pj}
+				for g := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC); g.Year() < 2009; g = g.AddDate(0, 0, 1) {
+					for _, j := range p.Hitung(g, o, hauls...) {
+						nama := fmt.Sprintf("%s/%s kurup %s pendhak jawa %v, geblag %s", p.ID, j.Acara.Nama, k, pj, g.Format("2006-01-02"))
+						hasil := j.Acara.GeblagDari(j.Tanggal, o)
+						ada := false
+						for _, h := range hasil {
+							if h.Equal(g) {
+								ada = true
+							}
+							if !j.Acara.Jadwal(h, o).Tanggal.Equal(j.Tanggal) {
+								t.Errorf("%s: geblag %s tidak menghasilkan %s", nama, h.Format("2006-01-02"), j.Tanggal.Format("2006-01-02"))
+							}
+						}
+						if !ada {
+							t.Fatalf("%s: GeblagDari(%s) = %v, tidak memuat geblag", nama, j.Tanggal.Format("2006-01-02"), hasil)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGeblagDariBesarWuntu(t *testing.T) {
+	o := Opsi{Kurup: jawa.Asapon}
+	a := Acara{Nama: "Pendhak Jawa", TahunJawa: 1}
+
+	// 29 dan 30 Besar 1956 (wuntu) sama-sama jatuh pada 29 Besar 1957 yang
+	// bukan wuntu.
+	tgl := func(d jawa.JavaneseDate) time.Time {
+		hasil, err := jawa.JavaneseToDate(d, o.Kurup, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hasil
+	}
+	target := tgl(jawa.JavaneseDate{Tanggal: 29, Bulan: 12, Tahun: 1957})
+	want := []time.Time{
+		tgl(jawa.JavaneseDate{Tanggal: 29, Bulan: 12, Tahun: 1956}),
+		tgl(jawa.JavaneseDate{Tanggal: 30, Bulan: 12, Tahun: 1956}),
+	}
+	got := a.GeblagDari(target, o)
+	if len(got) != len(want) {
+		t.Fatalf("GeblagDari(%s) = %v, want %v", target.Format("2006-01-02"), got, want)
+	}
+	for i := range want {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("GeblagDari[%d] = %s, want %s", i, got[i].Format("2006-01-02"), want[i].Format("2006-01-02"))
+		}
+	}
+}
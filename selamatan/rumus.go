@@ -0,0 +1,36 @@
+package selamatan
+
+import "fmt"
+
+// ==========================================
+// RUMUS HARI & PASARAN
+// ==========================================
+
+// Rumus adalah posisi hari dan pasaran sebuah acara dihitung dari geblag,
+// misalnya nelung jatuh pada hari ke-3 dan pasaran ke-3 (Lusarlu). Geblag
+// sendiri adalah hari dan pasaran ke-1.
+type Rumus struct {
+	Hari    int // 1..7
+	Pasaran int // 1..5
+}
+
+var (
+	singkatanRumus = []string{"", "ji", "ro", "lu", "pat", "ma", "nem", "tu"}
+	bilanganRumus  = []string{"", "siji", "loro", "telu", "papat", "limo", "enem", "pitu"}
+)
+
+// RumusOf menghitung rumus untuk acara yang jatuh selisih hari setelah geblag.
+func RumusOf(selisih int) Rumus {
+	return Rumus{Hari: selisih%7 + 1, Pasaran: selisih%5 + 1}
+}
+
+// String mengembalikan nama rumus, misalnya "Masarma".
+func (r Rumus) String() string {
+	s := singkatanRumus[r.Hari] + "sar" + singkatanRumus[r.Pasaran]
+	return string(s[0]-'a'+'A') + s[1:]
+}
+
+// Keterangan menguraikan rumus, misalnya "Dino ke limo pasaran ke limo".
+func (r Rumus) Keterangan() string {
+	return fmt.Sprintf("Dino ke %s pasaran ke %s", bilanganRumus[r.Hari], bilanganRumus[r.Pasaran])
+}
@@ -0,0 +1,27 @@
+package selamatan
+
+import "testing"
+
+func TestRumusOf(t *testing.T) {
+	tests := []struct {
+		selisih    int
+		want       string
+		keterangan string
+	}{
+		{0, "Jisarji", "Dino ke siji pasaran ke siji"},
+		{2, "Lusarlu", "Dino ke telu pasaran ke telu"},
+		{6, "Tusarro", "Dino ke pitu pasaran ke loro"},
+		{39, "Masarma", "Dino ke limo pasaran ke limo"},
+		{99, "Rosarma", "Dino ke loro pasaran ke limo"},
+		{353, "Patsarpat", "Dino ke papat pasaran ke papat"},
+		{707, "Jisarlu", "Dino ke siji pasaran ke telu"},
+		{999, "Nemsarma", "Dino ke enem pasaran ke limo"},
+		{35, "Jisarji", "Dino ke siji pasaran ke siji"}, // selapan
+	}
+	for _, tt := range tests {
+		r := RumusOf(tt.selisih)
+		if r.String() != tt.want || r.Keterangan() != tt.keterangan {
+			t.Errorf("RumusOf(%d) = %s (%s), want %s (%s)", tt.selisih, r, r.Keterangan(), tt.want, tt.keterangan)
+		}
+	}
+}
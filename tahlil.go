@@ -0,0 +1,298 @@
+package main
+
+import (
+	"fmt"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/bacaan"
+)
+
+// ==========================================
+// BACAAN TAHLIL, YASIN & DOA ARWAH
+// ==========================================
+
+const (
+	prefBacaanImpor  = "bacaan_impor"  // JSON []bacaan.Bacaan
+	prefBacaanUkuran = "bacaan_ukuran" // skala huruf pembaca, 1 = normal
+	prefBacaanLatin  = "bacaan_latin"
+	prefBacaanArti   = "bacaan_arti"
+
+	prefBacaanTerakhir = "bacaan_terakhir" // ID bacaan yang terakhir dibuka
+)
+
+// Batas skala huruf pembaca. Mode baca memperbesar lagi dengan skalaModeBaca.
+const (
+	ukuranMin     = 0.8
+	ukuranMaks    = 2.5
+	ukuranLangkah = 0.1
+	skalaModeBaca = 1.4
+)
+
+// daftarBacaan berisi bacaan bawaan diikuti bacaan impor. Bacaan impor yang
+// ID-nya sama dengan bacaan bawaan menggantikannya.
+func daftarBacaan(p fyne.Preferences) []bacaan.Bacaan {
+	var impor []bacaan.Bacaan
+	if err := loadJSONPref(p, prefBacaanImpor, &impor); err != nil {
+		fmt.Println("Bacaan impor rusak:", err)
+	}
+	hasil := bacaan.Bawaan()
+	for _, imp := range impor {
+		diganti := false
+		for i := range hasil {
+			if hasil[i].ID == imp.ID {
+				hasil[i] = imp
+				diganti = true
+			}
+		}
+		if !diganti {
+			hasil = append(hasil, imp)
+		}
+	}
+	return hasil
+}
+
+// imporBacaan menyimpan bacaan dari berkas pengguna. Bacaan dengan ID yang
+// sama diganti.
+func imporBacaan(p fyne.Preferences, b bacaan.Bacaan) {
+	var impor []bacaan.Bacaan
+	if err := loadJSONPref(p, prefBacaanImpor, &impor); err != nil {
+		fmt.Println("Bacaan impor rusak, ditimpa:", err)
+		impor = nil
+	}
+	for i := range impor {
+		if impor[i].ID == b.ID {
+			impor[i] = b
+			saveJSONPref(p, prefBacaanImpor, impor)
+			return
+		}
+	}
+	saveJSONPref(p, prefBacaanImpor, append(impor, b))
+}
+
+// temaUkuran memperbesar semua ukuran teks tema dengan skala tertentu.
+type temaUkuran struct {
+	fyne.Theme
+	skala float32
+}
+
+func (t temaUkuran) Size(name fyne.ThemeSizeName) float32 {
+	switch name {
+	case theme.SizeNameText, theme.SizeNameCaptionText, theme.SizeNameSubHeadingText, theme.SizeNameHeadingText:
+		return t.Theme.Size(name) * t.skala
+	}
+	return t.Theme.Size(name)
+}
+
+// judulBagian menambahkan jumlah ulangan, misalnya "Al-Ikhlas ×3".
+func judulBagian(bg bacaan.Bagian) string {
+	if bg.Ulang > 1 {
+		return fmt.Sprintf("%s ×%d", bg.Judul, bg.Ulang)
+	}
+	return bg.Judul
+}
+
+// createBagianView menyusun satu bagian bacaan: judul, tulisan Arab rata
+// kanan, latin miring dan arti. Ukuran huruf mengikuti tema induknya.
+func createBagianView(bg bacaan.Bagian, latin, arti bool, ukuranArab fyne.ThemeSizeName) fyne.CanvasObject {
+	box := container.NewVBox()
+
+	lblJudul := widget.NewLabel(judulBagian(bg))
+	lblJudul.TextStyle = fyne.TextStyle{Bold: true}
+	lblJudul.Importance = widget.SuccessImportance
+	box.Add(lblJudul)
+
+	if bg.Arab != "" {
+		lblArab := widget.NewLabel(bg.Arab)
+		lblArab.Wrapping = fyne.TextWrapWord
+		lblArab.Alignment = fyne.TextAlignTrailing
+		lblArab.SizeName = ukuranArab
+		box.Add(lblArab)
+	}
+	if latin && bg.Latin != "" {
+		lblLatin := widget.NewLabel(bg.Latin)
+		lblLatin.Wrapping = fyne.TextWrapWord
+		lblLatin.TextStyle = fyne.TextStyle{Italic: true}
+		box.Add(lblLatin)
+	}
+	if arti && bg.Arti != "" {
+		lblArti := widget.NewLabel(bg.Arti)
+		lblArti.Wrapping = fyne.TextWrapWord
+		lblArti.Importance = widget.LowImportance
+		box.Add(lblArti)
+	}
+	if bg.Catatan != "" {
+		lblCatatan := widget.NewLabel("Catatan: " + bg.Catatan)
+		lblCatatan.Wrapping = fyne.TextWrapWord
+		lblCatatan.SizeName = theme.SizeNameCaptionText
+		lblCatatan.Importance = widget.WarningImportance
+		box.Add(lblCatatan)
+	}
+	return box
+}
+
+// tombolUkuran membuat tombol A- dan A+ yang mengubah skala huruf tersimpan
+// lalu memanggil onChanged.
+func tombolUkuran(prefs fyne.Preferences, onChanged func()) (*widget.Button, *widget.Button) {
+	ubah := func(d float64) {
+		u := prefs.FloatWithFallback(prefBacaanUkuran, 1) + d
+		if u < ukuranMin {
+			u = ukuranMin
+		}
+		if u > ukuranMaks {
+			u = ukuranMaks
+		}
+		prefs.SetFloat(prefBacaanUkuran, u)
+		onChanged()
+	}
+	btnKecil := widget.NewButton("A-", func() { ubah(-ukuranLangkah) })
+	btnBesar := widget.NewButton("A+", func() { ubah(ukuranLangkah) })
+	return btnKecil, btnBesar
+}
+
+// showBacaanPopup menampilkan pembaca tahlil, Yasin dan doa arwah. Bacaannya
+// sama untuk setiap fase. Pilihan bacaan, ukuran huruf, latin dan arti
+// diingat untuk berikutnya.
+func showBacaanPopup(parentCanvas fyne.Canvas, prefs fyne.Preferences) {
+	daftar := daftarBacaan(prefs)
+	var nama []string
+	for _, b := range daftar {
+		nama = append(nama, b.Judul)
+	}
+
+	selBacaan := widget.NewSelect(nama, nil)
+	chkLatin := widget.NewCheck("Latin", nil)
+	chkLatin.SetChecked(prefs.BoolWithFallback(prefBacaanLatin, true))
+	chkArti := widget.NewCheck("Arti", nil)
+	chkArti.SetChecked(prefs.BoolWithFallback(prefBacaanArti, true))
+
+	lblKeterangan := widget.NewLabel("")
+	lblKeterangan.Wrapping = fyne.TextWrapWord
+	lblKeterangan.TextStyle = fyne.TextStyle{Italic: true}
+	isi := container.NewStack()
+
+	render := func() {
+		if selBacaan.SelectedIndex() < 0 {
+			return
+		}
+		b := daftar[selBacaan.SelectedIndex()]
+		lblKeterangan.SetText(b.Keterangan)
+		box := container.NewVBox()
+		for i, bg := range b.Bagian {
+			if i > 0 {
+				box.Add(widget.NewSeparator())
+			}
+			box.Add(createBagianView(bg, chkLatin.Checked, chkArti.Checked, theme.SizeNameSubHeadingText))
+		}
+		skala := float32(prefs.FloatWithFallback(prefBacaanUkuran, 1))
+		isi.Objects = []fyne.CanvasObject{container.NewThemeOverride(box, temaUkuran{Theme: fyne.CurrentApp().Settings().Theme(), skala: skala})}
+		isi.Refresh()
+	}
+
+	selBacaan.SetSelectedIndex(0)
+	for i, b := range daftar {
+		if b.ID == prefs.String(prefBacaanTerakhir) {
+			selBacaan.SetSelectedIndex(i)
+		}
+	}
+	render()
+	selBacaan.OnChanged = func(string) {
+		prefs.SetString(prefBacaanTerakhir, daftar[selBacaan.SelectedIndex()].ID)
+		render()
+	}
+	chkLatin.OnChanged = func(v bool) {
+		prefs.SetBool(prefBacaanLatin, v)
+		render()
+	}
+	chkArti.OnChanged = func(v bool) {
+		prefs.SetBool(prefBacaanArti, v)
+		render()
+	}
+	btnKecil, btnBesar := tombolUkuran(prefs, render)
+
+	body := container.NewVBox(
+		selBacaan,
+		container.NewHBox(chkLatin, chkArti, layout.NewSpacer(), btnKecil, btnBesar),
+		lblKeterangan,
+		widget.NewSeparator(),
+		isi,
+	)
+
+	btnModeBaca := widget.NewButtonWithIcon("Mode Baca", theme.ViewFullScreenIcon(), func() {
+		if selBacaan.SelectedIndex() >= 0 {
+			showModeBaca(parentCanvas, prefs, daftar[selBacaan.SelectedIndex()], render)
+		}
+	})
+	btnModeBaca.Importance = widget.HighImportance
+
+	showModalCard(parentCanvas, "Bacaan Tahlil", body, btnModeBaca)
+}
+
+// showModeBaca menampilkan bacaan satu bagian per layar dengan huruf besar,
+// untuk dibaca bersama saat acara. onClose dipanggil saat keluar agar ukuran
+// huruf di pembaca biasa ikut diperbarui.
+func showModeBaca(parentCanvas fyne.Canvas, prefs fyne.Preferences, b bacaan.Bacaan, onClose func()) {
+	pos := 0
+	lblPosisi := canvas.NewText("", ColorTextGrey)
+	lblPosisi.TextSize = 12
+	lblPosisi.Alignment = fyne.TextAlignCenter
+	isi := container.NewStack()
+	scroll := container.NewVScroll(container.NewPadded(isi))
+
+	var btnPrev, btnNext *widget.Button
+	render := func() {
+		lblPosisi.Text = fmt.Sprintf("%s · %d/%d", b.Judul, pos+1, len(b.Bagian))
+		lblPosisi.Refresh()
+		skala := float32(prefs.FloatWithFallback(prefBacaanUkuran, 1)) * skalaModeBaca
+		view := createBagianView(b.Bagian[pos], prefs.BoolWithFallback(prefBacaanLatin, true), prefs.BoolWithFallback(prefBacaanArti, true), theme.SizeNameHeadingText)
+		isi.Objects = []fyne.CanvasObject{container.NewThemeOverride(view, temaUkuran{Theme: fyne.CurrentApp().Settings().Theme(), skala: skala})}
+		isi.Refresh()
+		scroll.ScrollToTop()
+		if pos == 0 {
+			btnPrev.Disable()
+		} else {
+			btnPrev.Enable()
+		}
+		if pos == len(b.Bagian)-1 {
+			btnNext.Disable()
+		} else {
+			btnNext.Enable()
+		}
+	}
+	btnPrev = widget.NewButtonWithIcon("", theme.NavigateBackIcon(), func() {
+		if pos > 0 {
+			pos--
+			render()
+		}
+	})
+	btnNext = widget.NewButtonWithIcon("", theme.NavigateNextIcon(), func() {
+		if pos < len(b.Bagian)-1 {
+			pos++
+			render()
+		}
+	})
+	btnNext.Importance = widget.HighImportance
+	btnKecil, btnBesar := tombolUkuran(prefs, render)
+	render()
+
+	var popup *widget.PopUp
+	btnKeluar := widget.NewButtonWithIcon("Keluar", theme.ViewRestoreIcon(), func() {
+		popup.Hide()
+		if onClose != nil {
+			onClose()
+		}
+	})
+
+	bar := container.NewHBox(btnKeluar, layout.NewSpacer(), btnKecil, btnBesar, layout.NewSpacer(), btnPrev, btnNext)
+	bg := canvas.NewRectangle(ColorBgDark)
+	content := container.NewBorder(lblPosisi, container.NewPadded(bar), nil, nil, scroll)
+
+	popup = widget.NewModalPopUp(container.NewStack(bg, container.NewPadded(content)), parentCanvas)
+	popup.Resize(parentCanvas.Size())
+	popup.Show()
+}
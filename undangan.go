@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/dialog"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+
+	"github.com/richstoremipad/kalender-selamatan/jawa"
+	"github.com/richstoremipad/kalender-selamatan/selamatan"
+)
+
+// ==========================================
+// UNDANGAN TAHLIL
+// ==========================================
+
+const (
+	prefUndanganAlamat     = "undangan_alamat"
+	prefUndanganPengundang = "undangan_pengundang"
+	prefUndanganJam        = "undangan_jam"
+)
+
+// Template undangan ditulis dalam Markdown sederhana: baris "# " adalah judul,
+// teks di antara ** dicetak tebal, baris kosong memisahkan paragraf. Dari
+// bentuk ini dibuat teks biasa, Markdown dan PDF.
+var NamaTemplateUndangan = []string{"Resmi", "Singkat"}
+
+var templateUndangan = map[string]string{
+	"Resmi": `# Undangan Tahlil
+
+Assalamu'alaikum Warahmatullahi Wabarakatuh
+
+Mengharap kehadiran Bapak/Ibu/Saudara pada acara tahlil dan doa bersama dalam rangka peringatan **{acara} ({sub})** wafatnya **{nama}**, yang insyaallah akan dilaksanakan pada:
+
+**Hari/Tanggal:** {hari}, {tanggal}
+**Hari Jawa:** {weton}
+**Waktu:** {jam}
+**Tempat:** {alamat}
+
+Atas kehadiran dan doanya kami ucapkan terima kasih.
+
+Wassalamu'alaikum Warahmatullahi Wabarakatuh
+
+Kami yang berduka,
+**{pengundang}**`,
+	"Singkat": `# {acara} {nama}
+
+Mohon doa dan kehadirannya pada tahlilan {acara} ({sub}) {nama}:
+
+**{hari}, {tanggal}** ({weton}), {jam}
+di {alamat}
+
+Terima kasih.
+**{pengundang}**`,
+}
+
+// undangan adalah data yang mengisi template.
+type undangan struct {
+	Nama       string
+	Jadwal     selamatan.Jadwal
+	Malam      bool // tahlil diadakan malam sebelum hari acara (malam weton)
+	Jam        string
+	Alamat     string
+	Pengundang string
+}
+
+// Pelaksanaan adalah tanggal Masehi acara diadakan. Bila Malam, acara
+// diadakan sehari sebelumnya karena hari Jawa sudah berganti saat maghrib.
+func (u undangan) Pelaksanaan() time.Time {
+	if u.Malam {
+		return u.Jadwal.Tanggal.AddDate(0, 0, -1)
+	}
+	return u.Jadwal.Tanggal
+}
+
+// Weton mengembalikan "malam Jumat Legi" atau "Jumat Legi".
+func (u undangan) Weton() string {
+	w := jawa.WetonOf(u.Jadwal.Tanggal).String()
+	if u.Malam {
+		return "malam " + w
+	}
+	return w
+}
+
+// markdown mengisi template dengan data undangan.
+func (u undangan) markdown(tpl string) string {
+	t := u.Pelaksanaan()
+	return strings.NewReplacer(
+		"{nama}", u.Nama,
+		"{acara}", u.Jadwal.Acara.Nama,
+		"{sub}", u.Jadwal.Acara.Sub,
+		"{hari}", jawa.NamaHari[t.Weekday()],
+		"{tanggal}", formatIndoDate(t),
+		"{weton}", u.Weton(),
+		"{jam}", u.Jam,
+		"{alamat}", u.Alamat,
+		"{pengundang}", u.Pengundang,
+	).Replace(tpl)
+}
+
+// teksBiasa membuang tanda Markdown: judul ditulis kapital, tanda tebal
+// dihapus.
+func teksBiasa(md string) string {
+	var hasil []string
+	for _, l := range strings.Split(md, "\n") {
+		if strings.HasPrefix(l, "# ") {
+			l = strings.ToUpper(strings.TrimPrefix(l, "# "))
+		}
+		hasil = append(hasil, strings.ReplaceAll(l, "**", ""))
+	}
+	return strings.Join(hasil, "\n")
+}
+
+// paragrafPDF mengubah Markdown sederhana menjadi paragraf PDF. Tiap baris
+// menjadi paragraf sendiri dan judul ditengahkan.
+func paragrafPDF(md string) []barisPDF {
+	var hasil []barisPDF
+	for _, l := range strings.Split(md, "\n") {
+		l = strings.TrimSpace(l)
+		p := barisPDF{}
+		judul := strings.HasPrefix(l, "# ")
+		if judul {
+			l = strings.TrimPrefix(l, "# ")
+			p.Ukuran = 18
+			p.Tengah = true
+		}
+		tebal := false
+		for i, bagian := range strings.Split(l, "**") {
+			if i > 0 {
+				tebal = !tebal
+			}
+			for _, kata := range strings.Fields(bagian) {
+				p.Kata = append(p.Kata, kataPDF{Teks: kata, Tebal: tebal || judul})
+			}
+		}
+		hasil = append(hasil, p)
+	}
+	return hasil
+}
+
+// showUndanganPopup menampilkan pembuat undangan untuk salah satu acara di
+// jadwal e. Alamat, jam dan pengundang diingat untuk undangan berikutnya.
+func showUndanganPopup(a fyne.App, win fyne.Window, e jadwalEkspor) {
+	prefs := a.Preferences()
+	parentCanvas := win.Canvas()
+
+	now := time.Now()
+	now = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	var namaAcara []string
+	pilih := len(e.Jadwal) - 1
+	for i := len(e.Jadwal) - 1; i >= 0; i-- {
+		if !e.Jadwal[i].Tanggal.Before(now) {
+			pilih = i
+		}
+	}
+	for _, j := range e.Jadwal {
+		namaAcara = append(namaAcara, j.Acara.Nama+" · "+formatIndoDate(j.Tanggal))
+	}
+
+	nama := e.Nama
+	if nama == "" {
+		nama = "Almarhum/Almarhumah"
+	}
+
+	selAcara := widget.NewSelect(namaAcara, nil)
+	chkMalam := widget.NewCheck("Diadakan malam sebelumnya (malam weton)", nil)
+	chkMalam.SetChecked(true)
+	entryNama := widget.NewEntry()
+	entryNama.SetText(nama)
+	entryJam := widget.NewEntry()
+	entryJam.SetText(prefs.StringWithFallback(prefUndanganJam, "Ba'da Isya (pukul 19.30 WIB)"))
+	entryAlamat := widget.NewMultiLineEntry()
+	entryAlamat.Wrapping = fyne.TextWrapWord
+	entryAlamat.SetPlaceHolder("Alamat rumah duka")
+	entryAlamat.SetText(prefs.String(prefUndanganAlamat))
+	entryPengundang := widget.NewEntry()
+	entryPengundang.SetPlaceHolder("Keluarga yang mengundang")
+	entryPengundang.SetText(prefs.StringWithFallback(prefUndanganPengundang, "Keluarga Besar"))
+	selTemplate := widget.NewSelect(NamaTemplateUndangan, nil)
+
+	entryHasil := widget.NewMultiLineEntry()
+	entryHasil.Wrapping = fyne.TextWrapWord
+	entryHasil.SetMinRowsVisible(10)
+
+	ingat := func() {
+		prefs.SetString(prefUndanganJam, entryJam.Text)
+		prefs.SetString(prefUndanganAlamat, entryAlamat.Text)
+		prefs.SetString(prefUndanganPengundang, entryPengundang.Text)
+	}
+	data := func() undangan {
+		return undangan{
+			Nama:       strings.TrimSpace(entryNama.Text),
+			Jadwal:     e.Jadwal[selAcara.SelectedIndex()],
+			Malam:      chkMalam.Checked,
+			Jam:        strings.TrimSpace(entryJam.Text),
+			Alamat:     strings.Join(strings.Fields(entryAlamat.Text), " "),
+			Pengundang: strings.TrimSpace(entryPengundang.Text),
+		}
+	}
+	perbarui := func() {
+		if selAcara.SelectedIndex() < 0 || selTemplate.SelectedIndex() < 0 {
+			return
+		}
+		entryHasil.SetText(data().markdown(templateUndangan[selTemplate.Selected]))
+	}
+	selAcara.SetSelectedIndex(pilih)
+	selTemplate.SetSelectedIndex(0)
+	perbarui()
+	selAcara.OnChanged = func(string) { perbarui() }
+	selTemplate.OnChanged = func(string) { perbarui() }
+	chkMalam.OnChanged = func(bool) { perbarui() }
+	for _, en := range []*widget.Entry{entryNama, entryJam, entryAlamat, entryPengundang} {
+		en.OnChanged = func(string) { perbarui() }
+	}
+
+	label := func(s string) *canvas.Text {
+		t := canvas.NewText(s, ColorTextGrey)
+		t.TextSize = 12
+		return t
+	}
+	lblInfo := canvas.NewText("", ColorTextGrey)
+	lblInfo.TextSize = 11
+	body := container.NewVBox(
+		label("Acara:"), selAcara, chkMalam,
+		label("Nama:"), entryNama,
+		label("Waktu:"), entryJam,
+		label("Tempat:"), entryAlamat,
+		label("Pengundang:"), entryPengundang,
+		label("Template:"), selTemplate,
+		label("Hasil (bisa disunting, format Markdown):"), entryHasil,
+		lblInfo,
+	)
+
+	simpan := func(ext string, tulis func(*bytes.Buffer) error) {
+		ingat()
+		save := dialog.NewFileSave(func(w fyne.URIWriteCloser, err error) {
+			if err != nil || w == nil {
+				return
+			}
+			defer w.Close()
+			var buf bytes.Buffer
+			if err = tulis(&buf); err == nil {
+				_, err = w.Write(buf.Bytes())
+			}
+			if err != nil {
+				dialog.ShowError(err, win)
+			}
+		}, win)
+		j := e.Jadwal[selAcara.SelectedIndex()]
+		save.SetFileName(e.namaBerkas("-undangan-" + strings.ToLower(strings.ReplaceAll(j.Acara.Nama, " ", "-")) + ext))
+		save.Show()
+	}
+
+	btnSalin := widget.NewButtonWithIcon("Salin", theme.ContentCopyIcon(), func() {
+		ingat()
+		a.Clipboard().SetContent(teksBiasa(entryHasil.Text))
+		lblInfo.Text = "Teks undangan disalin ke clipboard."
+		lblInfo.Refresh()
+	})
+	btnMD := widget.NewButtonWithIcon(".md", theme.DocumentSaveIcon(), func() {
+		simpan(".md", func(b *bytes.Buffer) error {
+			_, err := b.WriteString(entryHasil.Text + "\n")
+			return err
+		})
+	})
+	btnTXT := widget.NewButtonWithIcon(".txt", theme.FileTextIcon(), func() {
+		simpan(".txt", func(b *bytes.Buffer) error {
+			_, err := b.WriteString(teksBiasa(entryHasil.Text) + "\n")
+			return err
+		})
+	})
+	btnPDF := widget.NewButtonWithIcon(".pdf", theme.DocumentPrintIcon(), func() {
+		simpan(".pdf", func(b *bytes.Buffer) error {
+			return tulisPDF(b, "Undangan "+data().Jadwal.Acara.Nama, paragrafPDF(entryHasil.Text))
+		})
+	})
+	btnPDF.Importance = widget.HighImportance
+
+	showModalCard(parentCanvas, "Undangan Tahlil", body, btnSalin, btnTXT, btnMD, btnPDF)
+}